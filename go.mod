@@ -1,8 +1,8 @@
 module go-dash
 
-go 1.18
+go 1.23
 
 require (
-	github.com/google/go-cmp v0.5.7 // indirect
-	golang.org/x/exp v0.0.0-20220318154914-8dddf5d87bd8 // indirect
+	github.com/google/go-cmp v0.5.7
+	golang.org/x/exp v0.0.0-20220318154914-8dddf5d87bd8
 )
@@ -0,0 +1,129 @@
+// Package keypath parses the path syntax shared by maps.Path and the lang
+// property helpers, such as a.b[2]["c.d"].
+package keypath
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Segment is a step of a path: a key, or an index if IsIndex is set.
+type Segment struct {
+	Key     string
+	Index   int
+	IsIndex bool
+}
+
+// SyntaxError reports the offset at which a path cannot be parsed.
+type SyntaxError struct {
+	Path   string
+	Offset int
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%q at offset %d", e.Path, e.Offset)
+}
+
+// Parse splits s into segments. A path is a sequence of keys separated by
+// dots, optionally followed by bracketed indices or quoted keys. Inside
+// quotes, a backslash escapes the next character.
+func Parse(s string) ([]Segment, error) {
+	segs := make([]Segment, 0)
+
+	i := 0
+	afterDot := false
+	for {
+		if i < len(s) && s[i] == '[' && !afterDot {
+			seg, n, ok := parseBracket(s, i)
+			if !ok {
+				return nil, &SyntaxError{s, i}
+			}
+			segs = append(segs, seg)
+			i = n
+		} else {
+			j := i
+			for j < len(s) && !strings.ContainsRune(".[]", rune(s[j])) {
+				j++
+			}
+			if j == i {
+				return nil, &SyntaxError{s, i}
+			}
+			segs = append(segs, Segment{Key: s[i:j]})
+			i = j
+		}
+
+		afterDot = false
+		if i == len(s) {
+			return segs, nil
+		}
+		switch s[i] {
+		case '.':
+			afterDot = true
+			i++
+		case '[':
+		default:
+			return nil, &SyntaxError{s, i}
+		}
+	}
+}
+
+func parseBracket(s string, i int) (Segment, int, bool) {
+	i++
+	if i < len(s) && (s[i] == '"' || s[i] == '\'') {
+		quote := s[i]
+		var key strings.Builder
+		for i++; i < len(s) && s[i] != quote; i++ {
+			if s[i] == '\\' {
+				i++
+				if i == len(s) {
+					return Segment{}, 0, false
+				}
+			}
+			key.WriteByte(s[i])
+		}
+		if i+1 >= len(s) || s[i+1] != ']' {
+			return Segment{}, 0, false
+		}
+		return Segment{Key: key.String()}, i + 2, true
+	}
+
+	j := i
+	for j < len(s) && '0' <= s[j] && s[j] <= '9' {
+		j++
+	}
+	if j == i || j == len(s) || s[j] != ']' {
+		return Segment{}, 0, false
+	}
+	index, err := strconv.Atoi(s[i:j])
+	if err != nil {
+		return Segment{}, 0, false
+	}
+	return Segment{Index: index, IsIndex: true}, j + 1, true
+}
+
+// Format returns segs in the syntax accepted by Parse.
+func Format(segs []Segment) string {
+	var b strings.Builder
+	for i, seg := range segs {
+		switch {
+		case seg.IsIndex:
+			fmt.Fprintf(&b, "[%d]", seg.Index)
+		case seg.Key != "" && !strings.ContainsAny(seg.Key, `.[]"'\`):
+			if i > 0 {
+				b.WriteByte('.')
+			}
+			b.WriteString(seg.Key)
+		default:
+			b.WriteString(`["`)
+			for _, r := range seg.Key {
+				if r == '"' || r == '\\' {
+					b.WriteByte('\\')
+				}
+				b.WriteRune(r)
+			}
+			b.WriteString(`"]`)
+		}
+	}
+	return b.String()
+}
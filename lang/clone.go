@@ -0,0 +1,140 @@
+package lang
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// Cloner is implemented by types that copy themselves. CloneDeep calls the
+// Clone method of any value of type T that implements Cloner[T], instead of
+// copying it by reflection.
+type Cloner[T any] interface {
+	Clone() T
+}
+
+// CloneDeep returns a deep copy of v. Slices, maps, pointers, arrays,
+// interfaces and structs, including their unexported fields, are copied
+// recursively. Values reached more than once, including through cycles, are
+// copied once, so the copy has the same shape as v. Map keys, channels,
+// functions and unsafe pointers are shared with v.
+func CloneDeep[T any](v T) T {
+	var ret T
+	c := &cloner{visited: map[visitKey]reflect.Value{}}
+	reflect.ValueOf(&ret).Elem().Set(c.clone(reflect.ValueOf(&v).Elem()))
+	return ret
+}
+
+type visitKey struct {
+	typ reflect.Type
+	ptr uintptr
+	len int
+}
+
+type cloner struct {
+	visited map[visitKey]reflect.Value
+}
+
+func (c *cloner) clone(v reflect.Value) reflect.Value {
+	if ret, ok := c.cloneSelf(v); ok {
+		return ret
+	}
+
+	switch v.Kind() {
+	case reflect.Array:
+		dst := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			dst.Index(i).Set(c.clone(v.Index(i)))
+		}
+		return dst
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		dst := reflect.New(v.Type()).Elem()
+		dst.Set(c.clone(v.Elem()))
+		return dst
+
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		key := visitKey{v.Type(), v.Pointer(), 0}
+		if dst, ok := c.visited[key]; ok {
+			return dst
+		}
+		dst := reflect.MakeMapWithSize(v.Type(), v.Len())
+		c.visited[key] = dst
+		for it := v.MapRange(); it.Next(); {
+			dst.SetMapIndex(it.Key(), c.clone(it.Value()))
+		}
+		return dst
+
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		key := visitKey{v.Type(), v.Pointer(), 0}
+		if dst, ok := c.visited[key]; ok {
+			return dst
+		}
+		dst := reflect.New(v.Type().Elem())
+		c.visited[key] = dst
+		dst.Elem().Set(c.clone(v.Elem()))
+		return dst
+
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		key := visitKey{v.Type(), v.Pointer(), v.Len()}
+		if dst, ok := c.visited[key]; ok {
+			return dst
+		}
+		dst := reflect.MakeSlice(v.Type(), v.Len(), v.Cap())
+		c.visited[key] = dst
+		for i := 0; i < v.Len(); i++ {
+			dst.Index(i).Set(c.clone(v.Index(i)))
+		}
+		return dst
+
+	case reflect.Struct:
+		v = addressable(v)
+		dst := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.NumField(); i++ {
+			accessible(dst.Field(i)).Set(c.clone(accessible(v.Field(i))))
+		}
+		return dst
+	}
+
+	return v
+}
+
+// cloneSelf calls the Clone method of v if its type T implements Cloner[T].
+func (c *cloner) cloneSelf(v reflect.Value) (reflect.Value, bool) {
+	switch v.Kind() {
+	case reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+	}
+
+	m := v.MethodByName("Clone")
+	if !m.IsValid() {
+		return reflect.Value{}, false
+	}
+	t := m.Type()
+	if t.NumIn() != 0 || t.NumOut() != 1 || t.Out(0) != v.Type() {
+		return reflect.Value{}, false
+	}
+	return m.Call(nil)[0], true
+}
+
+// accessible returns v, or a settable alias of v if it was obtained through
+// an unexported struct field. v must be addressable.
+func accessible(v reflect.Value) reflect.Value {
+	if v.CanInterface() {
+		return v
+	}
+	return reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem()
+}
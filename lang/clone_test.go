@@ -0,0 +1,114 @@
+package lang
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type cloneUser struct {
+	Name    string
+	Tags    []string
+	Attrs   map[string]any
+	Friend  *cloneUser
+	scores  []int
+	history [2]map[string]int
+}
+
+type cloneNode struct {
+	Value int
+	Next  *cloneNode
+}
+
+type cloneCounter struct {
+	calls *int
+	Items []int
+}
+
+func (c cloneCounter) Clone() cloneCounter {
+	*c.calls++
+	return cloneCounter{calls: c.calls, Items: append([]int{}, c.Items...)}
+}
+
+func TestCloneDeep(t *testing.T) {
+	{
+		input := cloneUser{
+			Name:    "alice",
+			Tags:    []string{"a", "b"},
+			Attrs:   map[string]any{"nested": []any{map[string]any{"k": 1}}},
+			Friend:  &cloneUser{Name: "bob"},
+			scores:  []int{1, 2},
+			history: [2]map[string]int{{"x": 1}, nil},
+		}
+		expect := cloneUser{
+			Name:    "alice",
+			Tags:    []string{"a", "b"},
+			Attrs:   map[string]any{"nested": []any{map[string]any{"k": 1}}},
+			Friend:  &cloneUser{Name: "bob"},
+			scores:  []int{1, 2},
+			history: [2]map[string]int{{"x": 1}, nil},
+		}
+
+		output := CloneDeep(input)
+		output.Tags[0] = "z"
+		output.Attrs["nested"].([]any)[0].(map[string]any)["k"] = 2
+		output.Friend.Name = "carol"
+		output.scores[0] = 9
+		output.history[0]["x"] = 9
+		if diff := cmp.Diff(expect, input, cmp.AllowUnexported(cloneUser{})); diff != "" {
+			t.Errorf("input is modified (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := &cloneNode{Value: 1}
+		input.Next = &cloneNode{Value: 2, Next: input}
+
+		output := CloneDeep(input)
+		if output == input || output.Next == input.Next {
+			t.Errorf("result shares pointers with input")
+		}
+		if output.Next.Next != output {
+			t.Errorf("result is missmatch: cycle is not preserved")
+		}
+		if diff := cmp.Diff([]int{1, 2}, []int{output.Value, output.Next.Value}); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []any{nil, 0}
+		input[0] = input
+
+		output := CloneDeep(input)
+		output[1] = 1
+		if diff := cmp.Diff(1, output[0].([]any)[1]); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff(0, input[1]); diff != "" {
+			t.Errorf("input is modified (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		calls := 0
+		input := map[string]cloneCounter{"a": {calls: &calls, Items: []int{1}}}
+
+		output := CloneDeep(input)
+		output["a"].Items[0] = 2
+		if diff := cmp.Diff(1, calls); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff([]int{1}, input["a"].Items); diff != "" {
+			t.Errorf("input is modified (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		var input any
+		var nilSlice []int
+
+		if output := CloneDeep(input); output != nil {
+			t.Errorf("result is missmatch: %v", output)
+		}
+		if output := CloneDeep(nilSlice); output != nil {
+			t.Errorf("result is missmatch: %v", output)
+		}
+	}
+}
@@ -0,0 +1,4 @@
+// Package lang provides lodash-style helpers that work on values of any type
+// through reflection: deep copying, deep comparison and property-based
+// predicates.
+package lang
@@ -0,0 +1,183 @@
+package lang
+
+import (
+	"math"
+	"reflect"
+)
+
+// EqualOptions customizes the comparison made by IsEqualWith.
+type EqualOptions struct {
+	// NilEqualsEmpty makes nil slices and maps equal to empty ones.
+	NilEqualsEmpty bool
+	// StrictNaN makes NaN unequal to itself, as the == operator does.
+	// By default, NaN equals NaN.
+	StrictNaN bool
+	// Customizer, if set, is called for every pair of values compared,
+	// starting with a and b themselves. If it returns handled as true, equal
+	// is used as the result for the pair and its contents are not compared.
+	Customizer func(a, b any) (equal, handled bool)
+}
+
+// IsEqual reports whether a and b are deeply equal. It is like
+// IsEqualWith with the zero EqualOptions, and can be passed directly to the
+// *With helpers, for example slices.UniqWith(ary, lang.IsEqual).
+func IsEqual[T any](a, b T) bool {
+	return IsEqualWith(a, b, EqualOptions{})
+}
+
+// IsEqualWith reports whether a and b are deeply equal according to opts.
+//
+// Values of different dynamic types are never equal. Arrays, slices and
+// structs, including their unexported fields, are equal if their elements
+// are; maps are equal if they have the same keys mapped to equal values;
+// pointers and interfaces are equal if they point to equal values. Functions
+// are equal only if both are nil. Comparison terminates on cyclic values.
+func IsEqualWith[T any](a, b T, opts EqualOptions) bool {
+	e := &equaler{opts: opts, visited: map[visitPair]bool{}}
+	return e.equal(reflect.ValueOf(&a).Elem(), reflect.ValueOf(&b).Elem())
+}
+
+type visitPair struct {
+	typ  reflect.Type
+	a, b uintptr
+	len  int
+}
+
+type equaler struct {
+	opts    EqualOptions
+	visited map[visitPair]bool
+}
+
+func (e *equaler) equal(a, b reflect.Value) bool {
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid()
+	}
+	if e.opts.Customizer != nil {
+		if eq, handled := e.opts.Customizer(a.Interface(), b.Interface()); handled {
+			return eq
+		}
+	}
+	if a.Type() != b.Type() {
+		return false
+	}
+
+	switch a.Kind() {
+	case reflect.Array:
+		for i := 0; i < a.Len(); i++ {
+			if !e.equal(a.Index(i), b.Index(i)) {
+				return false
+			}
+		}
+		return true
+
+	case reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		return e.equal(a.Elem(), b.Elem())
+
+	case reflect.Map:
+		if !e.sameLen(a, b) {
+			return false
+		}
+		if e.seen(a, b) {
+			return true
+		}
+		for it := a.MapRange(); it.Next(); {
+			bv := b.MapIndex(it.Key())
+			if !bv.IsValid() || !e.equal(it.Value(), bv) {
+				return false
+			}
+		}
+		return true
+
+	case reflect.Pointer:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		if e.seen(a, b) {
+			return true
+		}
+		return e.equal(a.Elem(), b.Elem())
+
+	case reflect.Slice:
+		if !e.sameLen(a, b) {
+			return false
+		}
+		if e.seen(a, b) {
+			return true
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !e.equal(a.Index(i), b.Index(i)) {
+				return false
+			}
+		}
+		return true
+
+	case reflect.Struct:
+		a, b = addressable(a), addressable(b)
+		for i := 0; i < a.NumField(); i++ {
+			if !e.equal(accessible(a.Field(i)), accessible(b.Field(i))) {
+				return false
+			}
+		}
+		return true
+
+	case reflect.Float32, reflect.Float64:
+		return e.floatEqual(a.Float(), b.Float())
+
+	case reflect.Complex64, reflect.Complex128:
+		ca, cb := a.Complex(), b.Complex()
+		return e.floatEqual(real(ca), real(cb)) && e.floatEqual(imag(ca), imag(cb))
+
+	case reflect.Func:
+		return a.IsNil() && b.IsNil()
+	}
+
+	return a.Equal(b)
+}
+
+// sameLen reports whether the slices or maps a and b have the same length,
+// and the same nilness unless NilEqualsEmpty is set.
+func (e *equaler) sameLen(a, b reflect.Value) bool {
+	if !e.opts.NilEqualsEmpty && a.IsNil() != b.IsNil() {
+		return false
+	}
+	return a.Len() == b.Len()
+}
+
+// seen records the pair a, b and reports whether it was already being
+// compared, which means the values are cyclic. Values sharing their contents
+// are equal too, unless StrictNaN or Customizer may tell their elements
+// apart.
+func (e *equaler) seen(a, b reflect.Value) bool {
+	key := visitPair{a.Type(), a.Pointer(), b.Pointer(), 0}
+	if a.Kind() == reflect.Slice {
+		key.len = a.Len()
+	}
+	if e.visited[key] {
+		return true
+	}
+	if key.a == key.b && !e.opts.StrictNaN && e.opts.Customizer == nil {
+		return true
+	}
+	e.visited[key] = true
+	return false
+}
+
+func (e *equaler) floatEqual(a, b float64) bool {
+	if math.IsNaN(a) && math.IsNaN(b) {
+		return !e.opts.StrictNaN
+	}
+	return a == b
+}
+
+// addressable returns v, or an addressable copy of v if it is not.
+func addressable(v reflect.Value) reflect.Value {
+	if v.CanAddr() {
+		return v
+	}
+	tmp := reflect.New(v.Type()).Elem()
+	tmp.Set(v)
+	return tmp
+}
@@ -0,0 +1,155 @@
+package lang
+
+import (
+	"math"
+	"testing"
+)
+
+type equalUser struct {
+	Name   string
+	Tags   []string
+	Friend *equalUser
+	score  float64
+}
+
+type equalSlices struct {
+	A, B []int
+}
+
+func TestIsEqual(t *testing.T) {
+	{
+		inputs := [][2]any{
+			{1, 1},
+			{"a", "a"},
+			{[]int{1, 2}, []int{1, 2}},
+			{map[string]any{"a": []any{1, map[string]int{"b": 2}}}, map[string]any{"a": []any{1, map[string]int{"b": 2}}}},
+			{equalUser{Name: "a", Tags: []string{"x"}, Friend: &equalUser{Name: "b"}, score: 1}, equalUser{Name: "a", Tags: []string{"x"}, Friend: &equalUser{Name: "b"}, score: 1}},
+			{math.NaN(), math.NaN()},
+			{[2]float64{1, math.NaN()}, [2]float64{1, math.NaN()}},
+			{nil, nil},
+		}
+
+		for _, input := range inputs {
+			if !IsEqual(input[0], input[1]) {
+				t.Errorf("result is missmatch: %v != %v", input[0], input[1])
+			}
+		}
+	}
+	{
+		inputs := [][2]any{
+			{1, 2},
+			{1, int64(1)},
+			{[]int{1, 2}, []int{1}},
+			{[]int{}, []int(nil)},
+			{map[string]int{"a": 1}, map[string]int{"b": 1}},
+			{map[string]int{}, map[string]int(nil)},
+			{equalUser{score: 1}, equalUser{score: 2}},
+			{&equalUser{Name: "a"}, &equalUser{Name: "b"}},
+			{&equalUser{}, (*equalUser)(nil)},
+			{1, nil},
+		}
+
+		for _, input := range inputs {
+			if IsEqual(input[0], input[1]) {
+				t.Errorf("result is missmatch: %v == %v", input[0], input[1])
+			}
+		}
+	}
+	{
+		a := &equalUser{Name: "a"}
+		a.Friend = a
+		b := &equalUser{Name: "a"}
+		b.Friend = &equalUser{Name: "a", Friend: b}
+
+		if !IsEqual(a, b) {
+			t.Errorf("result is missmatch: cyclic values are not equal")
+		}
+	}
+	{
+		b1 := []int{1, 2}
+		b2 := []int{1, 3}
+
+		if IsEqual(equalSlices{b1[:1], b1[:2]}, equalSlices{b2[:1], b2[:2]}) {
+			t.Errorf("result is missmatch: slices of different lengths share a visit")
+		}
+	}
+	{
+		uniq := func(ary []map[string]int, pred func(a, b map[string]int) bool) []map[string]int {
+			ret := make([]map[string]int, 0)
+			for _, v := range ary {
+				found := false
+				for _, r := range ret {
+					found = found || pred(v, r)
+				}
+				if !found {
+					ret = append(ret, v)
+				}
+			}
+			return ret
+		}
+		input := []map[string]int{{"a": 1}, {"a": 1}, {"b": 1}}
+
+		if output := uniq(input, IsEqual); len(output) != 2 {
+			t.Errorf("result is missmatch: %v", output)
+		}
+	}
+}
+
+func TestIsEqualWith(t *testing.T) {
+	{
+		opts := EqualOptions{NilEqualsEmpty: true}
+
+		if !IsEqualWith([]int{}, nil, opts) {
+			t.Errorf("result is missmatch: nil slice != empty slice")
+		}
+		if !IsEqualWith(map[string][]int{"a": nil}, map[string][]int{"a": {}}, opts) {
+			t.Errorf("result is missmatch: nil slice != empty slice")
+		}
+	}
+	{
+		opts := EqualOptions{StrictNaN: true}
+
+		if IsEqualWith(math.NaN(), math.NaN(), opts) {
+			t.Errorf("result is missmatch: NaN == NaN")
+		}
+		if IsEqualWith([]float64{math.NaN()}, []float64{math.NaN()}, opts) {
+			t.Errorf("result is missmatch: NaN == NaN")
+		}
+		shared := []float64{math.NaN()}
+		if IsEqualWith(shared, shared, opts) {
+			t.Errorf("result is missmatch: NaN == NaN")
+		}
+		ptr := &equalUser{score: math.NaN()}
+		if IsEqualWith(ptr, ptr, opts) {
+			t.Errorf("result is missmatch: NaN == NaN")
+		}
+	}
+	{
+		opts := EqualOptions{Customizer: func(a, b any) (bool, bool) {
+			sa, ok1 := a.(string)
+			sb, ok2 := b.(string)
+			if !ok1 || !ok2 {
+				return false, false
+			}
+			return len(sa) == len(sb), true
+		}}
+		a := equalUser{Name: "abc", Tags: []string{"x"}}
+		b := equalUser{Name: "xyz", Tags: []string{"y"}}
+
+		if !IsEqualWith(a, b, opts) {
+			t.Errorf("result is missmatch: customizer is ignored")
+		}
+		if IsEqualWith(a, equalUser{Name: "ab"}, opts) {
+			t.Errorf("result is missmatch: customizer is ignored")
+		}
+
+		never := EqualOptions{Customizer: func(a, b any) (bool, bool) {
+			_, ok := a.(string)
+			return false, ok
+		}}
+		shared := []string{"x"}
+		if IsEqualWith(shared, shared, never) {
+			t.Errorf("result is missmatch: customizer is ignored")
+		}
+	}
+}
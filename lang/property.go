@@ -0,0 +1,201 @@
+package lang
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"go-dash/internal/keypath"
+)
+
+// Property returns a function that extracts the value at path from its
+// argument, for use as a key function with helpers such as GroupBy, CountBy
+// and SortBy. The function returns the zero value of U if there is no value
+// at path or it is not a U.
+//
+// A path uses the syntax of maps.Path, such as "address.lines[0]". Each key
+// selects an exported struct field, by its `json` tag name or its Go name,
+// or an entry of a map with string keys. Pointers and interfaces on the way
+// are followed. Property panics if path cannot be parsed.
+func Property[T, U any](path string) func(T) U {
+	segs := mustParse(path)
+	return func(v T) U {
+		var ret U
+		if rv, ok := resolve(reflect.ValueOf(&v).Elem(), segs); ok {
+			ret, _ = rv.Interface().(U)
+		}
+		return ret
+	}
+}
+
+// Matches returns a predicate that reports whether its argument partially
+// matches partial, which is a struct or a map with string keys. Each field
+// or entry of partial must be present in the argument, as for Property, and
+// hold a deeply equal value, see IsEqual. Nested structs and maps are
+// matched partially too. Only the exported, non-zero fields of a struct
+// partial are considered, so Matches[User](User{Active: true}) matches every
+// active user.
+func Matches[T any](partial any) func(T) bool {
+	p := reflect.ValueOf(partial)
+	return func(v T) bool {
+		return matches(reflect.ValueOf(&v).Elem(), p)
+	}
+}
+
+// MatchesProperty returns a predicate that reports whether the value at path
+// in its argument matches value, as for Matches. It panics if path cannot be
+// parsed.
+func MatchesProperty[T any](path string, value any) func(T) bool {
+	segs := mustParse(path)
+	p := reflect.ValueOf(value)
+	return func(v T) bool {
+		rv, ok := resolve(reflect.ValueOf(&v).Elem(), segs)
+		return ok && matches(rv, p)
+	}
+}
+
+// Conforms returns a predicate that reports whether, for each path of preds,
+// its argument has a value at path for which the predicate returns true. It
+// panics if a path cannot be parsed.
+func Conforms[T any](preds map[string]func(any) bool) func(T) bool {
+	type conform struct {
+		segs []keypath.Segment
+		pred func(any) bool
+	}
+	conforms := make([]conform, 0, len(preds))
+	for path, pred := range preds {
+		conforms = append(conforms, conform{mustParse(path), pred})
+	}
+
+	return func(v T) bool {
+		rv := reflect.ValueOf(&v).Elem()
+		for _, c := range conforms {
+			cv, ok := resolve(rv, c.segs)
+			if !ok || !c.pred(cv.Interface()) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+func mustParse(path string) []keypath.Segment {
+	segs, err := keypath.Parse(path)
+	if err != nil {
+		panic(fmt.Sprintf("lang: invalid path: %v", err))
+	}
+	return segs
+}
+
+func matches(target, partial reflect.Value) bool {
+	t, p := indirect(target), indirect(partial)
+	if !p.IsValid() {
+		return !t.IsValid()
+	}
+	if !t.IsValid() {
+		return false
+	}
+
+	switch {
+	case p.Kind() == reflect.Map && p.Type().Key().Kind() == reflect.String:
+		for it := p.MapRange(); it.Next(); {
+			tv, ok := child(t, it.Key().String())
+			if !ok || !matches(tv, it.Value()) {
+				return false
+			}
+		}
+		return true
+
+	case p.Kind() == reflect.Struct:
+		for _, f := range reflect.VisibleFields(p.Type()) {
+			if !f.IsExported() || f.Anonymous {
+				continue
+			}
+			pv, err := p.FieldByIndexErr(f.Index)
+			if err != nil || pv.IsZero() {
+				continue
+			}
+			tv, ok := child(t, fieldName(f))
+			if !ok || !matches(tv, pv) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return IsEqual(t.Interface(), p.Interface())
+}
+
+func resolve(v reflect.Value, segs []keypath.Segment) (reflect.Value, bool) {
+	for _, seg := range segs {
+		v = indirect(v)
+		if !v.IsValid() {
+			return reflect.Value{}, false
+		}
+
+		if !seg.IsIndex {
+			next, ok := child(v, seg.Key)
+			if !ok {
+				return reflect.Value{}, false
+			}
+			v = next
+			continue
+		}
+
+		switch v.Kind() {
+		case reflect.Array, reflect.Slice:
+			if seg.Index >= v.Len() {
+				return reflect.Value{}, false
+			}
+			v = v.Index(seg.Index)
+		default:
+			return reflect.Value{}, false
+		}
+	}
+	return v, true
+}
+
+// child returns the exported field of the struct v whose `json` tag name or
+// Go name is key, or the entry of the map v under key.
+func child(v reflect.Value, key string) (reflect.Value, bool) {
+	switch v.Kind() {
+	case reflect.Struct:
+		for _, f := range reflect.VisibleFields(v.Type()) {
+			if !f.IsExported() || (f.Name != key && fieldName(f) != key) {
+				continue
+			}
+			fv, err := v.FieldByIndexErr(f.Index)
+			return fv, err == nil
+		}
+
+	case reflect.Map:
+		kt := v.Type().Key()
+		if kt.Kind() != reflect.String {
+			break
+		}
+		mv := v.MapIndex(reflect.ValueOf(key).Convert(kt))
+		return mv, mv.IsValid()
+	}
+	return reflect.Value{}, false
+}
+
+// fieldName returns the `json` tag name of f, or its Go name if it has none.
+func fieldName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return f.Name
+	}
+	return name
+}
+
+// indirect follows pointers and interfaces from v. It returns the zero Value
+// if it meets a nil one.
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
@@ -0,0 +1,186 @@
+package lang
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type propertyAddress struct {
+	City  string   `json:"city"`
+	Lines []string `json:"lines,omitempty"`
+}
+
+type propertyUser struct {
+	Name    string `json:"name"`
+	Age     int
+	Active  bool `json:"active"`
+	Address *propertyAddress
+	Meta    map[string]any `json:"meta"`
+	secret  string
+}
+
+func propertyUsers() []propertyUser {
+	return []propertyUser{
+		{Name: "alice", Age: 30, Active: true, Address: &propertyAddress{City: "tokyo", Lines: []string{"1-1"}}, Meta: map[string]any{"role": "admin"}},
+		{Name: "bob", Age: 25, Active: false, Address: &propertyAddress{City: "osaka"}, secret: "x"},
+		{Name: "carol", Age: 30, Active: true},
+	}
+}
+
+func filterUsers(ary []propertyUser, pred func(propertyUser) bool) []string {
+	names := make([]string, 0)
+	for _, u := range ary {
+		if pred(u) {
+			names = append(names, u.Name)
+		}
+	}
+	return names
+}
+
+func TestProperty(t *testing.T) {
+	users := propertyUsers()
+
+	{
+		expect := []any{"tokyo", "osaka", ""}
+
+		f := Property[propertyUser, string]("Address.city")
+		output := make([]any, 0)
+		for _, u := range users {
+			output = append(output, f(u))
+		}
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		inputs := map[string]any{
+			"name":                 "alice",
+			"Name":                 "alice",
+			"Age":                  30,
+			"Address.lines[0]":     "1-1",
+			"meta.role":            "admin",
+			`meta["role"]`:         "admin",
+			"Address.lines[1]":     nil,
+			"secret":               nil,
+			"Address.city.unknown": nil,
+		}
+
+		for input, expect := range inputs {
+			output := Property[propertyUser, any](input)(users[0])
+			if diff := cmp.Diff(expect, output); diff != "" {
+				t.Errorf("result is missmatch for %q (-expect, +result):\n%s", input, diff)
+			}
+		}
+	}
+	{
+		input := map[string]any{"a": []any{map[string]any{"b": 1.5}}}
+
+		output := Property[map[string]any, float64]("a[0].b")(input)
+		if diff := cmp.Diff(1.5, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		defer func() {
+			if recover() == nil {
+				t.Errorf("invalid path is accepted")
+			}
+		}()
+		Property[propertyUser, any]("a..b")
+	}
+}
+
+func TestMatches(t *testing.T) {
+	users := propertyUsers()
+
+	{
+		expect := []string{"alice", "carol"}
+
+		output := filterUsers(users, Matches[propertyUser](map[string]any{"active": true, "Age": 30}))
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		expect := []string{"alice", "carol"}
+
+		output := filterUsers(users, Matches[propertyUser](propertyUser{Active: true}))
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		expect := []string{"bob"}
+
+		output := filterUsers(users, Matches[propertyUser](map[string]any{"Address": map[string]any{"city": "osaka"}}))
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		expect := []string{}
+
+		output := filterUsers(users, Matches[propertyUser](map[string]any{"unknown": 1}))
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := map[string]any{"name": "alice", "tags": []any{"a", "b"}}
+
+		if !Matches[map[string]any](map[string]any{"tags": []any{"a", "b"}})(input) {
+			t.Errorf("result is missmatch: %v", false)
+		}
+		if Matches[map[string]any](map[string]any{"tags": []any{"a"}})(input) {
+			t.Errorf("result is missmatch: %v", true)
+		}
+	}
+}
+
+func TestMatchesProperty(t *testing.T) {
+	users := propertyUsers()
+
+	{
+		expect := []string{"alice"}
+
+		output := filterUsers(users, MatchesProperty[propertyUser]("Address.city", "tokyo"))
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		expect := []string{"alice", "bob"}
+
+		output := filterUsers(users, MatchesProperty[propertyUser]("Address", map[string]any{}))
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestConforms(t *testing.T) {
+	users := propertyUsers()
+
+	{
+		expect := []string{"carol"}
+
+		output := filterUsers(users, Conforms[propertyUser](map[string]func(any) bool{
+			"Age":  func(v any) bool { return v.(int) >= 30 },
+			"name": func(v any) bool { return v.(string) > "b" },
+		}))
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		expect := []string{"alice", "bob"}
+
+		output := filterUsers(users, Conforms[propertyUser](map[string]func(any) bool{
+			"Address.city": func(v any) bool { return v != "" },
+		}))
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
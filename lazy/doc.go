@@ -0,0 +1,9 @@
+// Package lazy provides iterator-based counterparts of the slices helpers.
+//
+// Operators take and return iter.Seq values and evaluate nothing until the
+// result is ranged over, so a pipeline such as
+//
+//	lazy.ToSlice(lazy.Take(lazy.Map(lazy.Filter(lazy.FromSlice(ary), pred), conv), 10))
+//
+// stops reading ary as soon as ten elements have been produced.
+package lazy
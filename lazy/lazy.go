@@ -0,0 +1,207 @@
+package lazy
+
+import "iter"
+
+// FromSlice returns a sequence over the elements of ary.
+func FromSlice[T any](ary []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range ary {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// FromMap returns a sequence over the entries of m in unspecified order.
+func FromMap[K comparable, V any](m map[K]V) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice collects the elements of seq into a new slice.
+func ToSlice[T any](seq iter.Seq[T]) []T {
+	out := make([]T, 0)
+	for v := range seq {
+		out = append(out, v)
+	}
+	return out
+}
+
+// ToMap collects the entries of seq into a new map. Later entries overwrite
+// earlier ones with the same key.
+func ToMap[K comparable, V any](seq iter.Seq2[K, V]) map[K]V {
+	out := map[K]V{}
+	for k, v := range seq {
+		out[k] = v
+	}
+	return out
+}
+
+// Chunk groups the elements of seq into slices of length size. The final
+// group holds the remaining elements. If size is less than 1, all elements
+// are returned as a single group. See slices.Chunk.
+func Chunk[T any](seq iter.Seq[T], size int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		chunk := make([]T, 0)
+		emitted := false
+		for v := range seq {
+			chunk = append(chunk, v)
+			if size >= 1 && len(chunk) == size {
+				if !yield(chunk) {
+					return
+				}
+				chunk = make([]T, 0)
+				emitted = true
+			}
+		}
+
+		if len(chunk) > 0 || !emitted {
+			yield(chunk)
+		}
+	}
+}
+
+// Drop skips the first n elements of seq.
+func Drop[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		i := 0
+		for v := range seq {
+			if i < n {
+				i++
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// DropWhile skips elements from the beginning of seq while pred returns true.
+func DropWhile[T any](seq iter.Seq[T], pred func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		dropping := true
+		for v := range seq {
+			if dropping && pred(v) {
+				continue
+			}
+			dropping = false
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Filter yields the elements of seq for which pred returns true.
+func Filter[T any](seq iter.Seq[T], pred func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if pred(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Map yields the result of conv for each element of seq.
+func Map[T, U any](seq iter.Seq[T], conv func(T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for v := range seq {
+			if !yield(conv(v)) {
+				return
+			}
+		}
+	}
+}
+
+// Take yields the first n elements of seq and then stops reading it.
+func Take[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+
+		i := 0
+		for v := range seq {
+			if !yield(v) {
+				return
+			}
+			i++
+			if i >= n {
+				return
+			}
+		}
+	}
+}
+
+// TakeWhile yields elements from the beginning of seq while pred returns true.
+func TakeWhile[T any](seq iter.Seq[T], pred func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if !pred(v) || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Uniq yields the first occurrence of each element of seq.
+func Uniq[T comparable](seq iter.Seq[T]) iter.Seq[T] {
+	identity := func(v T) T { return v }
+	return UniqBy(seq, identity)
+}
+
+// UniqBy is like Uniq, but compares the keys generated by conv.
+func UniqBy[T any, U comparable](seq iter.Seq[T], conv func(T) U) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		memo := make(map[U]struct{})
+		for v := range seq {
+			u := conv(v)
+			if _, ok := memo[u]; ok {
+				continue
+			}
+			memo[u] = struct{}{}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Zip groups the elements of seqs by index and stops at the end of the
+// shortest sequence. See slices.Zip.
+func Zip[T any](seqs ...iter.Seq[T]) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if len(seqs) == 0 {
+			return
+		}
+
+		nexts := make([]func() (T, bool), len(seqs))
+		for i, seq := range seqs {
+			next, stop := iter.Pull(seq)
+			defer stop()
+			nexts[i] = next
+		}
+
+		for {
+			tmp := make([]T, len(nexts))
+			for i, next := range nexts {
+				v, ok := next()
+				if !ok {
+					return
+				}
+				tmp[i] = v
+			}
+			if !yield(tmp) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package lazy
+
+import (
+	"fmt"
+	"iter"
+	"testing"
+
+	"go-dash/slices"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// counted returns a sequence over ary and a pointer to the number of elements
+// read from it so far.
+func counted[T any](ary []T) (iter.Seq[T], *int) {
+	n := 0
+	seq := func(yield func(T) bool) {
+		for _, v := range ary {
+			n++
+			if !yield(v) {
+				return
+			}
+		}
+	}
+	return seq, &n
+}
+
+func TestFromMap(t *testing.T) {
+	{
+		input := map[string]int{"a": 0, "b": 1, "c": 2}
+		expect := map[string]int{"a": 0, "b": 1, "c": 2}
+
+		output := ToMap(FromMap(input))
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestChunk(t *testing.T) {
+	inputs := [][]int{{0, 1, 2, 3, 4}, {0, 1, 2, 3}, {0, 1}, {}}
+	sizes := []int{2, 10, -1}
+
+	for _, input := range inputs {
+		for _, size := range sizes {
+			expect := slices.Chunk(input, size)
+
+			output := ToSlice(Chunk(FromSlice(input), size))
+			if diff := cmp.Diff(expect, output); diff != "" {
+				t.Errorf("Chunk(%v, %d) is missmatch (-expect, +result):\n%s", input, size, diff)
+			}
+		}
+	}
+}
+
+func TestDrop(t *testing.T) {
+	{
+		input := []int{0, 1, 2, 3, 4}
+		expect := []int{2, 3, 4}
+
+		output := ToSlice(Drop(FromSlice(input), 2))
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestDropWhile(t *testing.T) {
+	{
+		input := []int{0, 1, 2, 3, 0}
+		expect := []int{2, 3, 0}
+
+		output := ToSlice(DropWhile(FromSlice(input), func(v int) bool { return v < 2 }))
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestFilter(t *testing.T) {
+	{
+		input := []int{0, 1, 2, 3, 4, 5}
+		expect := []int{0, 2, 4}
+
+		output := ToSlice(Filter(FromSlice(input), func(v int) bool { return v%2 == 0 }))
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestMap(t *testing.T) {
+	{
+		input := []int{0, 1, 2}
+		expect := []string{"0", "1", "2"}
+
+		output := ToSlice(Map(FromSlice(input), func(v int) string {
+			return fmt.Sprintf("%d", v)
+		}))
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestTake(t *testing.T) {
+	{
+		input := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+		expect := []int{0, 4, 8}
+
+		seq, read := counted(input)
+		isEven := func(v int) bool { return v%2 == 0 }
+		double := func(v int) int { return v * 2 }
+
+		output := ToSlice(Take(Map(Filter(seq, isEven), double), 3))
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff(5, *read); diff != "" {
+			t.Errorf("read count is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []int{0, 1, 2}
+		expect := []int{}
+
+		output := ToSlice(Take(FromSlice(input), 0))
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestTakeWhile(t *testing.T) {
+	{
+		input := []int{0, 1, 2, 3, 0}
+		expect := []int{0, 1}
+
+		seq, read := counted(input)
+		output := ToSlice(TakeWhile(seq, func(v int) bool { return v < 2 }))
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff(3, *read); diff != "" {
+			t.Errorf("read count is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestUniq(t *testing.T) {
+	{
+		input := []int{10, 20, 50, 20, 30, 10}
+		expect := slices.Uniq(input)
+
+		output := ToSlice(Uniq(FromSlice(input)))
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestZip(t *testing.T) {
+	{
+		input1 := []int{0, 1, 2}
+		input2 := []int{3, 4}
+		expect := slices.Zip(input1, input2)
+
+		output := ToSlice(Zip(FromSlice(input1), FromSlice(input2)))
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		expect := [][]int{}
+
+		output := ToSlice(Zip[int]())
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
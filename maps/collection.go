@@ -1,6 +1,7 @@
 package maps
 
-func countBy[T any, K, U comparable](m map[K]T, f func(K, T) U) map[U]int {
+// CountBy returns the number of entries of m for each key generated by f.
+func CountBy[K comparable, V any, U comparable](m map[K]V, f func(K, V) U) map[U]int {
 	group := map[U]int{}
 	for k, v := range m {
 		group[f(k, v)] += 1
@@ -8,7 +9,9 @@ func countBy[T any, K, U comparable](m map[K]T, f func(K, T) U) map[U]int {
 	return group
 }
 
-func every[T any, K comparable](m map[K]T, pred func(K, T) bool) bool {
+// Every reports whether pred returns true for all entries of m.
+// It returns true for an empty map.
+func Every[K comparable, V any](m map[K]V, pred func(K, V) bool) bool {
 	for k, v := range m {
 		if !pred(k, v) {
 			return false
@@ -17,8 +20,9 @@ func every[T any, K comparable](m map[K]T, pred func(K, T) bool) bool {
 	return true
 }
 
-func filter[T any, K comparable](m map[K]T, pred func(K, T) bool) map[K]T {
-	n := map[K]T{}
+// Filter returns a new map of the entries of m for which pred returns true.
+func Filter[K comparable, V any](m map[K]V, pred func(K, V) bool) map[K]V {
+	n := map[K]V{}
 	for k, v := range m {
 		if pred(k, v) {
 			n[k] = v
@@ -27,7 +31,10 @@ func filter[T any, K comparable](m map[K]T, pred func(K, T) bool) map[K]T {
 	return n
 }
 
-func find[T any, K comparable](m map[K]T, pred func(K, T) bool) (K, T, bool) {
+// Find returns an entry of m for which pred returns true.
+// The boolean result reports whether such an entry was found. If several
+// entries match, which one is returned is unspecified.
+func Find[K comparable, V any](m map[K]V, pred func(K, V) bool) (K, V, bool) {
 	for k, v := range m {
 		if pred(k, v) {
 			return k, v, true
@@ -35,29 +42,32 @@ func find[T any, K comparable](m map[K]T, pred func(K, T) bool) (K, T, bool) {
 	}
 
 	var k K
-	var t T
-	return k, t, false
+	var v V
+	return k, v, false
 }
 
-func forEach[T any, K comparable](m map[K]T, f func(K, T)) {
+// ForEach calls f for each entry of m in unspecified order.
+func ForEach[K comparable, V any](m map[K]V, f func(K, V)) {
 	for k, v := range m {
 		f(k, v)
 	}
 }
 
-func groupBy[T any, K, U comparable](m map[K]T, f func(K, T) U) map[U]map[K]T {
-	group := map[U]map[K]T{}
+// GroupBy groups the entries of m by the key generated by f.
+func GroupBy[K comparable, V any, U comparable](m map[K]V, f func(K, V) U) map[U]map[K]V {
+	group := map[U]map[K]V{}
 	for k, v := range m {
 		key := f(k, v)
 		if _, ok := group[key]; !ok {
-			group[key] = map[K]T{}
+			group[key] = map[K]V{}
 		}
 		group[key][k] = v
 	}
 	return group
 }
 
-func includes[T, K comparable](m map[K]T, t T) bool {
+// Includes reports whether t is present among the values of m.
+func Includes[K, V comparable](m map[K]V, t V) bool {
 	for _, v := range m {
 		if v == t {
 			return true
@@ -66,7 +76,8 @@ func includes[T, K comparable](m map[K]T, t T) bool {
 	return false
 }
 
-func mapBy[T, U any, K comparable](m map[K]T, conv func(K, T) U) map[K]U {
+// Map returns a new map holding the result of conv for each entry of m.
+func Map[K comparable, V, U any](m map[K]V, conv func(K, V) U) map[K]U {
 	n := map[K]U{}
 	for k, v := range m {
 		n[k] = conv(k, v)
@@ -74,23 +85,27 @@ func mapBy[T, U any, K comparable](m map[K]T, conv func(K, T) U) map[K]U {
 	return n
 }
 
-func partition[T any, K, U comparable](m map[K]T, f func(K, T) U) []map[K]T {
-	parts := make([]map[K]T, 0)
-	group := groupBy(m, f)
+// Partition splits m into maps of entries that share the key generated by f.
+// The order of the maps is unspecified.
+func Partition[K comparable, V any, U comparable](m map[K]V, f func(K, V) U) []map[K]V {
+	parts := make([]map[K]V, 0)
+	group := GroupBy(m, f)
 	for _, v := range group {
 		parts = append(parts, v)
 	}
 	return parts
 }
 
-func reduce[T, U any, K comparable](m map[K]T, f func(K, T, U) U, acc U) U {
+// Reduce folds the entries of m into acc using f, in unspecified order.
+func Reduce[K comparable, V, U any](m map[K]V, f func(K, V, U) U, acc U) U {
 	for k, v := range m {
 		acc = f(k, v, acc)
 	}
 	return acc
 }
 
-func some[T any, K comparable](m map[K]T, pred func(K, T) bool) bool {
+// Some reports whether pred returns true for any entry of m.
+func Some[K comparable, V any](m map[K]V, pred func(K, V) bool) bool {
 	for k, v := range m {
 		if pred(k, v) {
 			return true
@@ -99,8 +114,10 @@ func some[T any, K comparable](m map[K]T, pred func(K, T) bool) bool {
 	return false
 }
 
-func reject[T any, K comparable](m map[K]T, pred func(K, T) bool) map[K]T {
-	ret := map[K]T{}
+// Reject is the opposite of Filter; it returns the entries of m for which pred
+// returns false.
+func Reject[K comparable, V any](m map[K]V, pred func(K, V) bool) map[K]V {
+	ret := map[K]V{}
 	for k, v := range m {
 		if !pred(k, v) {
 			ret[k] = v
@@ -108,3 +125,38 @@ func reject[T any, K comparable](m map[K]T, pred func(K, T) bool) map[K]T {
 	}
 	return ret
 }
+
+// Omit returns a new map of the entries of m whose key is not among keys.
+func Omit[K comparable, V any](m map[K]V, keys ...K) map[K]V {
+	omitted := make(map[K]struct{}, len(keys))
+	for _, k := range keys {
+		omitted[k] = struct{}{}
+	}
+
+	return Reject(m, func(k K, _ V) bool {
+		_, ok := omitted[k]
+		return ok
+	})
+}
+
+// OmitBy is an alias of Reject.
+func OmitBy[K comparable, V any](m map[K]V, pred func(K, V) bool) map[K]V {
+	return Reject(m, pred)
+}
+
+// Pick returns a new map of the entries of m whose key is among keys. Keys not
+// present in m are ignored.
+func Pick[K comparable, V any](m map[K]V, keys ...K) map[K]V {
+	n := map[K]V{}
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			n[k] = v
+		}
+	}
+	return n
+}
+
+// PickBy is an alias of Filter.
+func PickBy[K comparable, V any](m map[K]V, pred func(K, V) bool) map[K]V {
+	return Filter(m, pred)
+}
@@ -24,7 +24,7 @@ func TestCountBy(t *testing.T) {
 			}
 		}
 
-		output := countBy(input, f)
+		output := CountBy(input, f)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -42,7 +42,7 @@ func TestCountBy(t *testing.T) {
 			}
 		}
 
-		output := countBy(input, f)
+		output := CountBy(input, f)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -55,7 +55,7 @@ func TestCountBy(t *testing.T) {
 		}
 		f := func(k string, _ int) string { return string(k[0]) }
 
-		output := countBy(input, f)
+		output := CountBy(input, f)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -68,7 +68,7 @@ func TestEvery(t *testing.T) {
 		pred := func(_ string, v int) bool { return v%2 == 0 }
 		expect := true
 
-		output := every(input, pred)
+		output := Every(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -78,7 +78,7 @@ func TestEvery(t *testing.T) {
 		pred := func(_ string, v int) bool { return v%2 == 0 }
 		expect := false
 
-		output := every(input, pred)
+		output := Every(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -88,7 +88,7 @@ func TestEvery(t *testing.T) {
 		pred := func(k string, _ int) bool { return k[0] == 'a' }
 		expect := true
 
-		output := every(input, pred)
+		output := Every(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -101,7 +101,7 @@ func TestFilter(t *testing.T) {
 		expect := map[string]int{"a": 0, "c": 2, "e": 4}
 		f := func(_ string, v int) bool { return v%2 == 0 }
 
-		output := filter(input, f)
+		output := Filter(input, f)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -113,7 +113,7 @@ func TestFilter(t *testing.T) {
 			return strings.HasPrefix(v, "a")
 		}
 
-		output := filter(input, f)
+		output := Filter(input, f)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -123,7 +123,7 @@ func TestFilter(t *testing.T) {
 		expect := map[int]string{0: "a1", 2: "a2", 4: "a3"}
 		f := func(k int, _ string) bool { return k%2 == 0 }
 
-		output := filter(input, f)
+		output := Filter(input, f)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -135,14 +135,12 @@ func TestFind(t *testing.T) {
 		input := map[string]int{"a": 0, "b": 1, "c": 2, "d": 3, "e": 4}
 		f := func(_ string, v int) bool { return v%2 != 0 }
 
-		key, value, exist := find(input, f)
+		key, value, exist := Find(input, f)
 		if diff := cmp.Diff(true, exist); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
-		if diff := cmp.Diff(1, value); diff != "" {
-			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
-		}
-		if diff := cmp.Diff("b", key); diff != "" {
+		// Both "b" and "d" match; Find may return either. See FindSorted.
+		if diff := cmp.Diff(input[key], value); diff != "" || !f(key, value) {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
 	}
@@ -150,7 +148,7 @@ func TestFind(t *testing.T) {
 		input := map[string]int{"a": 0, "b": 1, "c": 2, "d": 3, "e": 4}
 		f := func(_ string, v int) bool { return v%2 == 3 }
 
-		_, _, exist := find(input, f)
+		_, _, exist := Find(input, f)
 		if diff := cmp.Diff(false, exist); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -159,7 +157,7 @@ func TestFind(t *testing.T) {
 		input := map[string]int{"a": 0, "b": 1, "c": 2, "d": 3, "e": 4}
 		f := func(k string, _ int) bool { return k == "b" }
 
-		key, value, exist := find(input, f)
+		key, value, exist := Find(input, f)
 		if diff := cmp.Diff(true, exist); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -177,7 +175,7 @@ func TestForEach(t *testing.T) {
 		input := map[string]int{"a": 0, "b": 1, "c": 2, "d": 3, "e": 4}
 		output := map[string]int{}
 
-		forEach(input, func(k string, v int) {
+		ForEach(input, func(k string, v int) {
 			output[k] = v
 		})
 		if diff := cmp.Diff(input, output); diff != "" {
@@ -189,7 +187,7 @@ func TestForEach(t *testing.T) {
 		expect := map[int]string{0: "a", 1: "b", 2: "c", 3: "d", 4: "e"}
 		output := map[int]string{}
 
-		forEach(input, func(k string, v int) {
+		ForEach(input, func(k string, v int) {
 			output[v] = k
 		})
 		if diff := cmp.Diff(expect, output); diff != "" {
@@ -213,7 +211,7 @@ func TestGroupBy(t *testing.T) {
 			}
 		}
 
-		output := groupBy(input, f)
+		output := GroupBy(input, f)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -231,7 +229,7 @@ func TestGroupBy(t *testing.T) {
 			}
 		}
 
-		output := groupBy(input, f)
+		output := GroupBy(input, f)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -244,7 +242,7 @@ func TestGroupBy(t *testing.T) {
 		}
 		f := func(k string, _ int) string { return string(k[0]) }
 
-		output := groupBy(input, f)
+		output := GroupBy(input, f)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -255,7 +253,7 @@ func TestIncludes(t *testing.T) {
 	{
 		input := map[string]int{"a": 0, "b": 1, "c": 2, "d": 3, "e": 4}
 
-		output := includes(input, 3)
+		output := Includes(input, 3)
 		if diff := cmp.Diff(true, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -263,20 +261,20 @@ func TestIncludes(t *testing.T) {
 	{
 		input := map[string]int{"a": 0, "b": 1, "c": 2, "d": 3, "e": 4}
 
-		output := includes(input, -1)
+		output := Includes(input, -1)
 		if diff := cmp.Diff(false, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
 	}
 }
 
-func TestMapBy(t *testing.T) {
+func TestMap(t *testing.T) {
 	{
 		input := map[string]int{"a": 0, "b": 1, "c": 2, "d": 3, "e": 4}
 		expect := map[string]int{"a": 0, "b": 2, "c": 4, "d": 6, "e": 8}
 		f := func(_ string, v int) int { return v * 2 }
 
-		output := mapBy(input, f)
+		output := Map(input, f)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -288,7 +286,7 @@ func TestMapBy(t *testing.T) {
 			return fmt.Sprintf("%d", v)
 		}
 
-		output := mapBy(input, f)
+		output := Map(input, f)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -314,7 +312,7 @@ func TestPartition(t *testing.T) {
 			}
 		}
 
-		output := partition(input, f)
+		output := Partition(input, f)
 		if diff := cmp.Diff(expect, output, trans); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -330,7 +328,7 @@ func TestPartition(t *testing.T) {
 			}
 		}
 
-		output := partition(input, f)
+		output := Partition(input, f)
 		if diff := cmp.Diff(expect, output, trans); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -340,7 +338,7 @@ func TestPartition(t *testing.T) {
 		expect := []map[string]int{{"a0": 0, "a1": 2, "a2": 4}, {"b0": 1, "b1": 3}}
 		f := func(k string, _ int) string { return string(k[0]) }
 
-		output := partition(input, f)
+		output := Partition(input, f)
 		if diff := cmp.Diff(expect, output, trans); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -353,7 +351,7 @@ func TestReduce(t *testing.T) {
 		expect := 10
 		f := func(_ string, v int, acc int) int { return acc + v }
 
-		output := reduce(input, f, 0)
+		output := Reduce(input, f, 0)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -366,7 +364,7 @@ func TestReduce(t *testing.T) {
 			return acc
 		}
 
-		output := reduce(input, f, map[string]int{})
+		output := Reduce(input, f, map[string]int{})
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -379,7 +377,7 @@ func TestSome(t *testing.T) {
 		pred := func(_ string, v int) bool { return v%2 == 0 }
 		expect := true
 
-		output := some(input, pred)
+		output := Some(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -389,7 +387,7 @@ func TestSome(t *testing.T) {
 		pred := func(_ string, v int) bool { return v%2 == 0 }
 		expect := false
 
-		output := some(input, pred)
+		output := Some(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -402,7 +400,7 @@ func TestReject(t *testing.T) {
 		pred := func(_ string, v int) bool { return v%2 == 0 }
 		expect := map[string]int{"b": 1, "d": 3}
 
-		output := reject(input, pred)
+		output := Reject(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -412,7 +410,7 @@ func TestReject(t *testing.T) {
 		pred := func(_ string, v int) bool { return v%2 == 0 }
 		expect := map[string]int{"b": 1, "d": 3}
 
-		output := reject(input, pred)
+		output := Reject(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -422,7 +420,73 @@ func TestReject(t *testing.T) {
 		pred := func(k string, _ int) bool { return k[0] == 'a' }
 		expect := map[string]int{"b0": 1, "b1": 3}
 
-		output := reject(input, pred)
+		output := Reject(input, pred)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestOmit(t *testing.T) {
+	{
+		input := map[string]int{"a": 0, "b": 1, "c": 2}
+		expect := map[string]int{"b": 1}
+
+		output := Omit(input, "a", "c", "z")
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := map[string]int{"a": 0, "b": 1}
+		expect := map[string]int{"a": 0, "b": 1}
+
+		output := Omit(input)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestOmitBy(t *testing.T) {
+	{
+		input := map[string]int{"a": 0, "b": 1, "c": 2}
+		expect := map[string]int{"b": 1}
+
+		output := OmitBy(input, func(_ string, v int) bool { return v%2 == 0 })
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestPick(t *testing.T) {
+	{
+		input := map[string]int{"a": 0, "b": 1, "c": 2}
+		expect := map[string]int{"a": 0, "c": 2}
+
+		output := Pick(input, "a", "c", "z")
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := map[string]int{"a": 0, "b": 1}
+		expect := map[string]int{}
+
+		output := Pick(input)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestPickBy(t *testing.T) {
+	{
+		input := map[string]int{"a": 0, "b": 1, "c": 2}
+		expect := map[string]int{"a": 0, "c": 2}
+
+		output := PickBy(input, func(_ string, v int) bool { return v%2 == 0 })
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
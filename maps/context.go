@@ -0,0 +1,27 @@
+package maps
+
+import "context"
+
+// ForEachContext is like ForEach, but checks ctx before each entry and
+// returns ctx.Err() once it is done.
+func ForEachContext[K comparable, V any](ctx context.Context, m map[K]V, f func(K, V)) error {
+	for k, v := range m {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		f(k, v)
+	}
+	return nil
+}
+
+// ReduceContext is like Reduce, but checks ctx before each entry. Once ctx is
+// done, it returns the accumulator so far along with ctx.Err().
+func ReduceContext[K comparable, V, U any](ctx context.Context, m map[K]V, f func(K, V, U) U, acc U) (U, error) {
+	for k, v := range m {
+		if err := ctx.Err(); err != nil {
+			return acc, err
+		}
+		acc = f(k, v, acc)
+	}
+	return acc, nil
+}
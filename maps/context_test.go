@@ -0,0 +1,63 @@
+package maps
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestForEachContext(t *testing.T) {
+	{
+		input := map[string]int{"a": 0, "b": 1, "c": 2}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		calls := 0
+		err := ForEachContext(ctx, input, func(_ string, _ int) {
+			calls++
+			cancel()
+		})
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("error is missmatch: %v", err)
+		}
+		if diff := cmp.Diff(1, calls); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestReduceContext(t *testing.T) {
+	{
+		input := map[string]int{"a": 1, "b": 2, "c": 3}
+		expect := 6
+
+		output, err := ReduceContext(context.Background(), input, func(_ string, v int, acc int) int {
+			return acc + v
+		}, 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := map[string]int{"a": 1, "b": 2, "c": 3}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		output, err := ReduceContext(ctx, input, func(_ string, v int, acc int) int {
+			return acc + v
+		}, 0)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("error is missmatch: %v", err)
+		}
+		if diff := cmp.Diff(0, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package maps
+
+import "golang.org/x/exp/constraints"
+
+// Entry is a key/value pair of a map.
+type Entry[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// Entries returns the entries of m in unspecified order.
+func Entries[K comparable, V any](m map[K]V) []Entry[K, V] {
+	out := make([]Entry[K, V], 0, len(m))
+	for k, v := range m {
+		out = append(out, Entry[K, V]{Key: k, Value: v})
+	}
+	return out
+}
+
+// FromEntries returns a new map holding entries. Later entries overwrite
+// earlier ones with the same key.
+func FromEntries[K comparable, V any](entries []Entry[K, V]) map[K]V {
+	out := make(map[K]V, len(entries))
+	for _, e := range entries {
+		out[e.Key] = e.Value
+	}
+	return out
+}
+
+// Keys returns the keys of m in unspecified order.
+func Keys[K comparable, V any](m map[K]V) []K {
+	out := make([]K, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	return out
+}
+
+// SortedEntries returns the entries of m in ascending key order.
+func SortedEntries[K constraints.Ordered, V any](m map[K]V) []Entry[K, V] {
+	return sortedEntriesFunc(m, less[K])
+}
+
+// SortedKeys returns the keys of m in ascending order.
+func SortedKeys[K constraints.Ordered, V any](m map[K]V) []K {
+	return sortedKeysFunc(m, less[K])
+}
+
+// SortedValues returns the values of m in ascending order of their keys, so
+// the result lines up with SortedKeys.
+func SortedValues[K constraints.Ordered, V any](m map[K]V) []V {
+	entries := SortedEntries(m)
+	out := make([]V, len(entries))
+	for i, e := range entries {
+		out[i] = e.Value
+	}
+	return out
+}
+
+// Values returns the values of m in unspecified order.
+func Values[K comparable, V any](m map[K]V) []V {
+	out := make([]V, 0, len(m))
+	for _, v := range m {
+		out = append(out, v)
+	}
+	return out
+}
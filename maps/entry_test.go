@@ -0,0 +1,136 @@
+package maps
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"go-dash/slices"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestEntries(t *testing.T) {
+	{
+		input := map[string]int{"b": 1, "a": 0, "c": 2}
+		expect := []Entry[string, int]{{"a", 0}, {"b", 1}, {"c", 2}}
+
+		output := Entries(input)
+		sort.Slice(output, func(i, j int) bool { return output[i].Key < output[j].Key })
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestFromEntries(t *testing.T) {
+	{
+		input := []Entry[string, int]{{"a", 0}, {"b", 1}, {"a", 2}}
+		expect := map[string]int{"a": 2, "b": 1}
+
+		output := FromEntries(input)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := map[string]int{"a": 0, "b": 1, "c": 2, "d": 3}
+		expect := map[string]int{"b": 1, "d": 3}
+
+		odd := func(e Entry[string, int]) bool { return e.Value%2 != 0 }
+		output := FromEntries(slices.Filter(Entries(input), odd))
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestKeys(t *testing.T) {
+	{
+		input := map[string]int{"b": 1, "a": 0, "c": 2}
+		expect := []string{"a", "b", "c"}
+
+		output := Keys(input)
+		sort.Strings(output)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := map[string]int{}
+		expect := []string{}
+
+		output := Keys(input)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestSortedEntries(t *testing.T) {
+	{
+		input := map[string]int{"b": 1, "a": 5, "c": 2}
+		expect := []Entry[string, int]{{"a", 5}, {"b", 1}, {"c", 2}}
+
+		output := SortedEntries(input)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := map[float64]int{math.NaN(): 5, 1: 2}
+		expect := []Entry[float64, int]{{math.NaN(), 5}, {1, 2}}
+
+		output := SortedEntries(input)
+		if diff := cmp.Diff(expect, output, cmpopts.EquateNaNs()); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestSortedKeys(t *testing.T) {
+	{
+		input := map[int]string{3: "c", 1: "a", 2: "b"}
+		expect := []int{1, 2, 3}
+
+		output := SortedKeys(input)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestSortedValues(t *testing.T) {
+	{
+		input := map[string]int{"b": 1, "a": 5, "c": 2}
+		expect := []int{5, 1, 2}
+
+		output := SortedValues(input)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := map[float64]int{math.NaN(): 5, 1: 2}
+		expect := []int{5, 2}
+
+		output := SortedValues(input)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestValues(t *testing.T) {
+	{
+		input := map[string]int{"b": 1, "a": 0, "c": 2}
+		expect := []int{0, 1, 2}
+
+		output := Values(input)
+		sort.Ints(output)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
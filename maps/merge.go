@@ -0,0 +1,139 @@
+package maps
+
+import (
+	"reflect"
+
+	"go-dash/slices"
+)
+
+// SliceMergePolicy selects how DeepMerge combines two []any values found
+// under the same key.
+type SliceMergePolicy int
+
+const (
+	// ReplaceSlices keeps the slice of the later map.
+	ReplaceSlices SliceMergePolicy = iota
+	// AppendSlices appends the elements of the later slice to the earlier one.
+	AppendSlices
+	// UnionSlices appends the elements of the later slice that are not yet
+	// present, comparing them with reflect.DeepEqual. See slices.UnionWith.
+	UnionSlices
+)
+
+// DeepMerge returns a new map holding the entries of ms, where later maps
+// take precedence. Nested map[string]any values are merged recursively, and
+// []any values are combined according to policy. Nested map[string]any and
+// []any values of the result are new, so modifying them does not affect ms;
+// values of other types are copied shallowly.
+func DeepMerge(policy SliceMergePolicy, ms ...map[string]any) map[string]any {
+	n := map[string]any{}
+	for _, m := range ms {
+		for k, v := range m {
+			n[k] = deepMergeValue(policy, n[k], v)
+		}
+	}
+	return n
+}
+
+// Defaults returns a new map holding the entries of m, plus the entries of
+// srcs whose key is not yet present. Earlier sources take precedence.
+func Defaults[K comparable, V any](m map[K]V, srcs ...map[K]V) map[K]V {
+	n := make(map[K]V, len(m))
+	for k, v := range m {
+		n[k] = v
+	}
+	for _, src := range srcs {
+		for k, v := range src {
+			if _, ok := n[k]; !ok {
+				n[k] = v
+			}
+		}
+	}
+	return n
+}
+
+// DefaultsDeep is like Defaults, but fills missing keys of nested
+// map[string]any values recursively. As with DeepMerge, nested
+// map[string]any and []any values of the result are new, so modifying them
+// does not affect m or srcs.
+func DefaultsDeep(m map[string]any, srcs ...map[string]any) map[string]any {
+	n := DeepMerge(ReplaceSlices, m)
+	for _, src := range srcs {
+		for k, v := range src {
+			cur, ok := n[k]
+			if !ok {
+				n[k] = deepMergeValue(ReplaceSlices, nil, v)
+				continue
+			}
+			d, dok := cur.(map[string]any)
+			s, sok := v.(map[string]any)
+			if dok && sok {
+				n[k] = DefaultsDeep(d, s)
+			}
+		}
+	}
+	return n
+}
+
+// Merge returns a new map holding the entries of ms. If a key is present in
+// several maps, the value of the last one wins.
+func Merge[K comparable, V any](ms ...map[K]V) map[K]V {
+	return MergeWith(func(_ K, _ V, b V) V { return b }, ms...)
+}
+
+// MergeWith is like Merge, but resolves a key present in several maps by
+// calling f with the key, the value merged so far and the next value.
+func MergeWith[K comparable, V any](f func(K, V, V) V, ms ...map[K]V) map[K]V {
+	n := map[K]V{}
+	for _, m := range ms {
+		for k, v := range m {
+			if prev, ok := n[k]; ok {
+				v = f(k, prev, v)
+			}
+			n[k] = v
+		}
+	}
+	return n
+}
+
+func deepMergeValue(policy SliceMergePolicy, dst, src any) any {
+	switch s := src.(type) {
+	case map[string]any:
+		if d, ok := dst.(map[string]any); ok {
+			return DeepMerge(policy, d, s)
+		}
+		return DeepMerge(policy, s)
+	case []any:
+		s = cloneValue(s).([]any)
+		d, ok := dst.([]any)
+		if !ok {
+			return s
+		}
+		switch policy {
+		case AppendSlices:
+			return slices.Concat(d, s)
+		case UnionSlices:
+			return slices.UnionWith(d, s, func(a, b any) bool { return reflect.DeepEqual(a, b) })
+		}
+		return s
+	}
+	return src
+}
+
+// cloneValue returns v with every nested map[string]any and []any copied.
+func cloneValue(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		return DeepMerge(ReplaceSlices, t)
+	case []any:
+		if t == nil {
+			return t
+		}
+		n := make([]any, len(t))
+		for i, e := range t {
+			n[i] = cloneValue(e)
+		}
+		return n
+	}
+	return v
+}
@@ -0,0 +1,218 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMerge(t *testing.T) {
+	{
+		input1 := map[string]int{"a": 0, "b": 1}
+		input2 := map[string]int{"b": 2, "c": 3}
+		input3 := map[string]int{"c": 4}
+		expect := map[string]int{"a": 0, "b": 2, "c": 4}
+
+		output := Merge(input1, input2, input3)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff(map[string]int{"a": 0, "b": 1}, input1); diff != "" {
+			t.Errorf("input is modified (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		expect := map[string]int{}
+
+		output := Merge[string, int]()
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestMergeWith(t *testing.T) {
+	{
+		input1 := map[string]int{"a": 1, "b": 2}
+		input2 := map[string]int{"b": 3, "c": 4}
+		input3 := map[string]int{"b": 5}
+		expect := map[string]int{"a": 1, "b": 10, "c": 4}
+		sum := func(_ string, a, b int) int { return a + b }
+
+		output := MergeWith(sum, input1, input2, input3)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestDeepMerge(t *testing.T) {
+	base := func() map[string]any {
+		return map[string]any{
+			"name": "app",
+			"server": map[string]any{
+				"host": "localhost",
+				"port": 80,
+				"tags": []any{"a", "b"},
+			},
+		}
+	}
+	override := func() map[string]any {
+		return map[string]any{
+			"server": map[string]any{
+				"port": 8080,
+				"tags": []any{"b", "c"},
+			},
+			"debug": true,
+		}
+	}
+
+	{
+		expect := map[string]any{
+			"name": "app",
+			"server": map[string]any{
+				"host": "localhost",
+				"port": 8080,
+				"tags": []any{"b", "c"},
+			},
+			"debug": true,
+		}
+
+		output := DeepMerge(ReplaceSlices, base(), override())
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		expect := []any{"a", "b", "b", "c"}
+
+		output := DeepMerge(AppendSlices, base(), override())
+		if diff := cmp.Diff(expect, output["server"].(map[string]any)["tags"]); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		expect := []any{"a", "b", "c"}
+
+		output := DeepMerge(UnionSlices, base(), override())
+		if diff := cmp.Diff(expect, output["server"].(map[string]any)["tags"]); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input1 := base()
+		input2 := map[string]any{"server": "disabled"}
+		expect := map[string]any{"name": "app", "server": "disabled"}
+
+		output := DeepMerge(ReplaceSlices, input1, input2)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := base()
+
+		output := DeepMerge(ReplaceSlices, input)
+		output["server"].(map[string]any)["host"] = "example.com"
+		if diff := cmp.Diff(base(), input); diff != "" {
+			t.Errorf("input is modified (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input1 := map[string]any{"items": []any{map[string]any{"id": 1}}}
+		input2 := map[string]any{"tags": []any{"a"}}
+
+		output := DeepMerge(ReplaceSlices, input1, input2)
+		output["items"].([]any)[0].(map[string]any)["id"] = 2
+		output["tags"].([]any)[0] = "b"
+		if diff := cmp.Diff(map[string]any{"items": []any{map[string]any{"id": 1}}}, input1); diff != "" {
+			t.Errorf("input is modified (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff(map[string]any{"tags": []any{"a"}}, input2); diff != "" {
+			t.Errorf("input is modified (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestDefaults(t *testing.T) {
+	{
+		input := map[string]int{"a": 0, "b": 1}
+		src1 := map[string]int{"b": 2, "c": 3}
+		src2 := map[string]int{"c": 4, "d": 5}
+		expect := map[string]int{"a": 0, "b": 1, "c": 3, "d": 5}
+
+		output := Defaults(input, src1, src2)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff(map[string]int{"a": 0, "b": 1}, input); diff != "" {
+			t.Errorf("input is modified (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestDefaultsDeep(t *testing.T) {
+	{
+		input := map[string]any{
+			"server": map[string]any{"port": 8080},
+			"debug":  true,
+		}
+		src := map[string]any{
+			"name": "app",
+			"server": map[string]any{
+				"host": "localhost",
+				"port": 80,
+			},
+			"debug": false,
+		}
+		expect := map[string]any{
+			"name": "app",
+			"server": map[string]any{
+				"host": "localhost",
+				"port": 8080,
+			},
+			"debug": true,
+		}
+
+		output := DefaultsDeep(input, src)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff(map[string]any{"port": 8080}, input["server"]); diff != "" {
+			t.Errorf("input is modified (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := map[string]any{"server": "disabled"}
+		src := map[string]any{"server": map[string]any{"host": "localhost"}}
+		expect := map[string]any{"server": "disabled"}
+
+		output := DefaultsDeep(input, src)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		src := map[string]any{"server": map[string]any{"host": "localhost"}}
+
+		output := DefaultsDeep(nil, src)
+		output["server"].(map[string]any)["host"] = "example.com"
+		if diff := cmp.Diff(map[string]any{"host": "localhost"}, src["server"]); diff != "" {
+			t.Errorf("input is modified (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := map[string]any{"tags": []any{"a"}}
+		src := map[string]any{"hosts": []any{map[string]any{"name": "a"}}}
+
+		output := DefaultsDeep(input, src)
+		output["tags"].([]any)[0] = "b"
+		output["hosts"].([]any)[0].(map[string]any)["name"] = "b"
+		if diff := cmp.Diff(map[string]any{"tags": []any{"a"}}, input); diff != "" {
+			t.Errorf("input is modified (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff(map[string]any{"hosts": []any{map[string]any{"name": "a"}}}, src); diff != "" {
+			t.Errorf("input is modified (-expect, +result):\n%s", diff)
+		}
+	}
+}
@@ -0,0 +1,255 @@
+package maps
+
+import "iter"
+
+// OrderedMap is a map that remembers the order in which keys were first set.
+// Iteration and every helper visit entries in that order, so results are
+// reproducible. The zero value is an empty map ready to use.
+//
+// Helpers that keep the entry types are methods (Filter, Find, ...). Helpers
+// that change them are functions with an Ordered suffix (MapOrdered,
+// GroupByOrdered, ...), since Go methods cannot declare type parameters.
+type OrderedMap[K comparable, V any] struct {
+	entries    map[K]*orderedEntry[K, V]
+	head, tail *orderedEntry[K, V]
+}
+
+type orderedEntry[K comparable, V any] struct {
+	key        K
+	value      V
+	prev, next *orderedEntry[K, V]
+}
+
+// NewOrdered returns an OrderedMap holding entries in the given order.
+func NewOrdered[K comparable, V any](entries ...Entry[K, V]) *OrderedMap[K, V] {
+	m := &OrderedMap[K, V]{entries: make(map[K]*orderedEntry[K, V], len(entries))}
+	for _, e := range entries {
+		m.Set(e.Key, e.Value)
+	}
+	return m
+}
+
+// All returns an iterator over the entries of m in insertion order.
+func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := m.head; e != nil; e = e.next {
+			if !yield(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// Delete removes the entry for k and reports whether it was present.
+func (m *OrderedMap[K, V]) Delete(k K) bool {
+	e, ok := m.entries[k]
+	if !ok {
+		return false
+	}
+
+	if e.prev != nil {
+		e.prev.next = e.next
+	} else {
+		m.head = e.next
+	}
+	if e.next != nil {
+		e.next.prev = e.prev
+	} else {
+		m.tail = e.prev
+	}
+	delete(m.entries, k)
+	return true
+}
+
+// Entries returns the entries of m in insertion order.
+func (m *OrderedMap[K, V]) Entries() []Entry[K, V] {
+	out := make([]Entry[K, V], 0, m.Len())
+	for k, v := range m.All() {
+		out = append(out, Entry[K, V]{Key: k, Value: v})
+	}
+	return out
+}
+
+// Get returns the value for k. The boolean result reports whether k is
+// present.
+func (m *OrderedMap[K, V]) Get(k K) (V, bool) {
+	if e, ok := m.entries[k]; ok {
+		return e.value, true
+	}
+
+	var v V
+	return v, false
+}
+
+// Has reports whether k is present in m.
+func (m *OrderedMap[K, V]) Has(k K) bool {
+	_, ok := m.entries[k]
+	return ok
+}
+
+// Keys returns the keys of m in insertion order.
+func (m *OrderedMap[K, V]) Keys() []K {
+	out := make([]K, 0, m.Len())
+	for k := range m.All() {
+		out = append(out, k)
+	}
+	return out
+}
+
+// Len returns the number of entries in m.
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.entries)
+}
+
+// Set sets the value for k. A key that is already present keeps its position.
+func (m *OrderedMap[K, V]) Set(k K, v V) {
+	if e, ok := m.entries[k]; ok {
+		e.value = v
+		return
+	}
+	if m.entries == nil {
+		m.entries = map[K]*orderedEntry[K, V]{}
+	}
+
+	e := &orderedEntry[K, V]{key: k, value: v, prev: m.tail}
+	if m.tail != nil {
+		m.tail.next = e
+	} else {
+		m.head = e
+	}
+	m.tail = e
+	m.entries[k] = e
+}
+
+// ToMap returns the entries of m as a plain map.
+func (m *OrderedMap[K, V]) ToMap() map[K]V {
+	out := make(map[K]V, m.Len())
+	for k, v := range m.All() {
+		out[k] = v
+	}
+	return out
+}
+
+// Values returns the values of m in insertion order.
+func (m *OrderedMap[K, V]) Values() []V {
+	out := make([]V, 0, m.Len())
+	for _, v := range m.All() {
+		out = append(out, v)
+	}
+	return out
+}
+
+// Every reports whether pred returns true for all entries of m. See Every.
+func (m *OrderedMap[K, V]) Every(pred func(K, V) bool) bool {
+	for k, v := range m.All() {
+		if !pred(k, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Filter returns a new OrderedMap of the entries for which pred returns true.
+// See Filter.
+func (m *OrderedMap[K, V]) Filter(pred func(K, V) bool) *OrderedMap[K, V] {
+	n := NewOrdered[K, V]()
+	for k, v := range m.All() {
+		if pred(k, v) {
+			n.Set(k, v)
+		}
+	}
+	return n
+}
+
+// Find returns the first entry in insertion order for which pred returns
+// true. See Find.
+func (m *OrderedMap[K, V]) Find(pred func(K, V) bool) (K, V, bool) {
+	for k, v := range m.All() {
+		if pred(k, v) {
+			return k, v, true
+		}
+	}
+
+	var k K
+	var v V
+	return k, v, false
+}
+
+// ForEach calls f for each entry of m in insertion order. See ForEach.
+func (m *OrderedMap[K, V]) ForEach(f func(K, V)) {
+	for k, v := range m.All() {
+		f(k, v)
+	}
+}
+
+// Reject returns a new OrderedMap of the entries for which pred returns false.
+// See Reject.
+func (m *OrderedMap[K, V]) Reject(pred func(K, V) bool) *OrderedMap[K, V] {
+	return m.Filter(func(k K, v V) bool { return !pred(k, v) })
+}
+
+// Some reports whether pred returns true for any entry of m. See Some.
+func (m *OrderedMap[K, V]) Some(pred func(K, V) bool) bool {
+	for k, v := range m.All() {
+		if pred(k, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// CountByOrdered is like CountBy. Counts are ordered by the first entry that
+// produced each key.
+func CountByOrdered[K comparable, V any, U comparable](m *OrderedMap[K, V], f func(K, V) U) *OrderedMap[U, int] {
+	group := NewOrdered[U, int]()
+	for k, v := range m.All() {
+		key := f(k, v)
+		n, _ := group.Get(key)
+		group.Set(key, n+1)
+	}
+	return group
+}
+
+// GroupByOrdered is like GroupBy. Groups are ordered by the first entry that
+// produced each key, and entries within a group keep their order.
+func GroupByOrdered[K comparable, V any, U comparable](m *OrderedMap[K, V], f func(K, V) U) *OrderedMap[U, *OrderedMap[K, V]] {
+	group := NewOrdered[U, *OrderedMap[K, V]]()
+	for k, v := range m.All() {
+		key := f(k, v)
+		part, ok := group.Get(key)
+		if !ok {
+			part = NewOrdered[K, V]()
+			group.Set(key, part)
+		}
+		part.Set(k, v)
+	}
+	return group
+}
+
+// IncludesOrdered is like Includes.
+func IncludesOrdered[K, V comparable](m *OrderedMap[K, V], t V) bool {
+	return m.Some(func(_ K, v V) bool { return v == t })
+}
+
+// MapOrdered is like Map. The result keeps the order of m.
+func MapOrdered[K comparable, V, U any](m *OrderedMap[K, V], conv func(K, V) U) *OrderedMap[K, U] {
+	n := NewOrdered[K, U]()
+	for k, v := range m.All() {
+		n.Set(k, conv(k, v))
+	}
+	return n
+}
+
+// PartitionOrdered is like Partition. The parts are ordered by the first entry
+// that produced each key.
+func PartitionOrdered[K comparable, V any, U comparable](m *OrderedMap[K, V], f func(K, V) U) []*OrderedMap[K, V] {
+	return GroupByOrdered(m, f).Values()
+}
+
+// ReduceOrdered is like Reduce, but folds the entries in insertion order.
+func ReduceOrdered[K comparable, V, U any](m *OrderedMap[K, V], f func(K, V, U) U, acc U) U {
+	for k, v := range m.All() {
+		acc = f(k, v, acc)
+	}
+	return acc
+}
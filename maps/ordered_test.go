@@ -0,0 +1,179 @@
+package maps
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func newOrderedInput() *OrderedMap[string, int] {
+	return NewOrdered(
+		Entry[string, int]{"e", 4},
+		Entry[string, int]{"b", 1},
+		Entry[string, int]{"d", 3},
+		Entry[string, int]{"a", 0},
+		Entry[string, int]{"c", 2},
+	)
+}
+
+func TestOrderedMap(t *testing.T) {
+	{
+		var m OrderedMap[string, int]
+		m.Set("b", 1)
+		m.Set("a", 0)
+		m.Set("c", 2)
+		m.Set("b", 10)
+
+		if diff := cmp.Diff([]string{"b", "a", "c"}, m.Keys()); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff([]int{10, 0, 2}, m.Values()); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+
+		v, ok := m.Get("b")
+		if diff := cmp.Diff(10, v); diff != "" || !ok {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		m := newOrderedInput()
+		for _, k := range []string{"e", "d", "c"} {
+			if !m.Delete(k) {
+				t.Errorf("%s is not deleted", k)
+			}
+		}
+		if m.Delete("z") {
+			t.Errorf("missing key is deleted")
+		}
+		m.Set("e", 5)
+		expect := []Entry[string, int]{{"b", 1}, {"a", 0}, {"e", 5}}
+
+		if diff := cmp.Diff(expect, m.Entries()); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff(3, m.Len()); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff(map[string]int{"b": 1, "a": 0, "e": 5}, m.ToMap()); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestOrderedMapFilter(t *testing.T) {
+	{
+		expect := []string{"e", "d", "c"}
+
+		output := newOrderedInput().Filter(func(_ string, v int) bool { return v >= 2 })
+		if diff := cmp.Diff(expect, output.Keys()); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		expect := []string{"b", "a"}
+
+		output := newOrderedInput().Reject(func(_ string, v int) bool { return v >= 2 })
+		if diff := cmp.Diff(expect, output.Keys()); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestOrderedMapFind(t *testing.T) {
+	{
+		key, value, exist := newOrderedInput().Find(func(_ string, v int) bool { return v%2 != 0 })
+		if diff := cmp.Diff(true, exist); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff("b", key); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff(1, value); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestCountByOrdered(t *testing.T) {
+	{
+		expect := []Entry[string, int]{{"even", 3}, {"odd", 2}}
+
+		output := CountByOrdered(newOrderedInput(), func(_ string, v int) string {
+			if v%2 == 0 {
+				return "even"
+			}
+			return "odd"
+		})
+		if diff := cmp.Diff(expect, output.Entries()); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestGroupByOrdered(t *testing.T) {
+	{
+		output := GroupByOrdered(newOrderedInput(), func(_ string, v int) bool { return v%2 == 0 })
+		if diff := cmp.Diff([]bool{true, false}, output.Keys()); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+
+		even, _ := output.Get(true)
+		if diff := cmp.Diff([]string{"e", "a", "c"}, even.Keys()); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestIncludesOrdered(t *testing.T) {
+	{
+		if !IncludesOrdered(newOrderedInput(), 3) {
+			t.Errorf("3 is not found")
+		}
+		if IncludesOrdered(newOrderedInput(), 7) {
+			t.Errorf("7 is found")
+		}
+	}
+}
+
+func TestMapOrdered(t *testing.T) {
+	{
+		expect := []Entry[string, string]{{"e", "e4"}, {"b", "b1"}, {"d", "d3"}, {"a", "a0"}, {"c", "c2"}}
+
+		output := MapOrdered(newOrderedInput(), func(k string, v int) string {
+			return fmt.Sprintf("%s%d", k, v)
+		})
+		if diff := cmp.Diff(expect, output.Entries()); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestPartitionOrdered(t *testing.T) {
+	{
+		expect := [][]string{{"e", "d", "c"}, {"b", "a"}}
+
+		parts := PartitionOrdered(newOrderedInput(), func(_ string, v int) bool { return v >= 2 })
+		output := make([][]string, 0)
+		for _, part := range parts {
+			output = append(output, part.Keys())
+		}
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestReduceOrdered(t *testing.T) {
+	{
+		expect := "ebdac"
+
+		output := ReduceOrdered(newOrderedInput(), func(k string, _ int, acc string) string {
+			return acc + k
+		}, "")
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package maps
+
+import "go-dash/slices"
+
+// ParallelFilter is like Filter, but calls pred from up to workers goroutines.
+// If workers is less than 1, runtime.GOMAXPROCS(0) is used. A panic in pred
+// is re-raised in the caller.
+func ParallelFilter[K comparable, V any](m map[K]V, workers int, pred func(K, V) bool) map[K]V {
+	entries := Entries(m)
+	keep := slices.ParallelMap(entries, workers, func(e Entry[K, V]) bool {
+		return pred(e.Key, e.Value)
+	})
+
+	n := map[K]V{}
+	for i, e := range entries {
+		if keep[i] {
+			n[e.Key] = e.Value
+		}
+	}
+	return n
+}
+
+// ParallelMap is like Map, but calls conv from up to workers goroutines.
+// If workers is less than 1, runtime.GOMAXPROCS(0) is used. A panic in conv
+// is re-raised in the caller.
+func ParallelMap[K comparable, V, U any](m map[K]V, workers int, conv func(K, V) U) map[K]U {
+	entries := Entries(m)
+	values := slices.ParallelMap(entries, workers, func(e Entry[K, V]) U {
+		return conv(e.Key, e.Value)
+	})
+
+	n := make(map[K]U, len(entries))
+	for i, e := range entries {
+		n[e.Key] = values[i]
+	}
+	return n
+}
@@ -0,0 +1,79 @@
+package maps
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParallelFilter(t *testing.T) {
+	{
+		input := map[string]int{}
+		for i := 0; i < 100; i++ {
+			input[fmt.Sprintf("k%d", i)] = i
+		}
+		pred := func(_ string, v int) bool { return v%2 == 0 }
+		expect := Filter(input, pred)
+
+		output := ParallelFilter(input, 4, pred)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := map[float64]int{math.NaN(): 5, 1: 2, 2: 3}
+
+		output := ParallelFilter(input, 2, func(_ float64, v int) bool { return v != 3 })
+		if diff := cmp.Diff([]int{2, 5}, sortedInts(Values(output))); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestParallelMap(t *testing.T) {
+	{
+		input := map[string]int{"a": 0, "b": 1, "c": 2}
+		expect := map[string]string{"a": "a0", "b": "b1", "c": "c2"}
+
+		output := ParallelMap(input, 2, func(k string, v int) string {
+			return fmt.Sprintf("%s%d", k, v)
+		})
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := map[float64]int{math.NaN(): 5, 1: 2}
+
+		output := ParallelMap(input, 2, func(_ float64, v int) int { return v * 10 })
+		if diff := cmp.Diff([]int{20, 50}, sortedInts(Values(output))); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := map[string]int{"a": 0, "b": 1, "c": 2}
+		expect := "boom"
+
+		output := func() (p any) {
+			defer func() { p = recover() }()
+			ParallelMap(input, 2, func(k string, v int) int {
+				if k == "b" {
+					panic("boom")
+				}
+				return v
+			})
+			return nil
+		}()
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func sortedInts(ary []int) []int {
+	sort.Ints(ary)
+	return ary
+}
@@ -0,0 +1,345 @@
+package maps
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+
+	"go-dash/internal/keypath"
+)
+
+var (
+	// ErrInvalidPath is returned when a path string cannot be parsed.
+	ErrInvalidPath = errors.New("maps: invalid path")
+	// ErrPathNotFound is returned when a path does not lead to a value.
+	ErrPathNotFound = errors.New("maps: path not found")
+	// ErrTypeMismatch is returned when a value on a path does not have the
+	// expected type.
+	ErrTypeMismatch = errors.New("maps: type mismatch")
+)
+
+// Path is a parsed path into nested map[string]any and []any values, as
+// produced by encoding/json. Parse a path once with ParsePath to reuse it.
+//
+// A path is a sequence of keys separated by dots, optionally followed by
+// bracketed indices or quoted keys, for example
+//
+//	a.b[2].c
+//	a["b.c"]['d']
+//
+// Inside quotes, a backslash escapes the next character.
+type Path struct {
+	segs []keypath.Segment
+}
+
+// ParsePath parses s into a Path. The error wraps ErrInvalidPath.
+func ParsePath(s string) (Path, error) {
+	segs, err := keypath.Parse(s)
+	if err != nil {
+		return Path{}, fmt.Errorf("%w: %v", ErrInvalidPath, err)
+	}
+	return Path{segs: segs}, nil
+}
+
+// MustParsePath is like ParsePath but panics if s cannot be parsed.
+func MustParsePath(s string) Path {
+	p, err := ParsePath(s)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// String returns p in the syntax accepted by ParsePath.
+func (p Path) String() string {
+	return keypath.Format(p.segs)
+}
+
+// Get returns the value at p in m. The error wraps ErrPathNotFound if a key
+// is missing, an index is out of range or a value on the way is not a
+// map[string]any or []any.
+func (p Path) Get(m map[string]any) (any, error) {
+	var cur any = m
+	for _, seg := range p.segs {
+		next, ok := getSegment(seg, cur)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrPathNotFound, p)
+		}
+		cur = next
+	}
+	return cur, nil
+}
+
+// GetOr returns the value at p in m, or def if there is none.
+func (p Path) GetOr(m map[string]any, def any) any {
+	v, err := p.Get(m)
+	if err != nil {
+		return def
+	}
+	return v
+}
+
+// GetString returns the string at p in m. The error wraps ErrTypeMismatch
+// if the value is not a string.
+func (p Path) GetString(m map[string]any) (string, error) {
+	v, err := p.Get(m)
+	if err != nil {
+		return "", err
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("%w: %s is %T, not string", ErrTypeMismatch, p, v)
+	}
+	return s, nil
+}
+
+// GetInt returns the integer at p in m. Besides Go integer types it accepts
+// float64 and float32 values without a fractional part, as decoded by
+// encoding/json, and json.Number. The error wraps ErrTypeMismatch if the
+// value is not an integer or does not fit in an int.
+func (p Path) GetInt(m map[string]any) (int, error) {
+	v, err := p.Get(m)
+	if err != nil {
+		return 0, err
+	}
+	n, ok := toInt(v)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s is %T, not int", ErrTypeMismatch, p, v)
+	}
+	return n, nil
+}
+
+// Has reports whether there is a value at p in m.
+func (p Path) Has(m map[string]any) bool {
+	_, err := p.Get(m)
+	return err == nil
+}
+
+// Set stores v at p in m, creating missing maps and slices on the way. An
+// index equal to the length of a slice appends to it, and the grown slice is
+// stored back into its parent; a larger index fails with an error wrapping
+// ErrPathNotFound. The error wraps ErrTypeMismatch if a value on the way is
+// neither nil nor of the kind the path expects, and ErrInvalidPath if p is
+// the zero Path. m is left unchanged on error. As with a map assignment, Set
+// panics if m is nil.
+func (p Path) Set(m map[string]any, v any) error {
+	if m == nil {
+		panic("maps: Set on nil map")
+	}
+	if len(p.segs) == 0 {
+		return fmt.Errorf("%w: empty path", ErrInvalidPath)
+	}
+	_, err := setPath(m, p.segs, v)
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, p)
+	}
+	return nil
+}
+
+// Unset removes the value at p from m and reports whether there was one.
+// A map entry is deleted; a slice element is removed by storing a new,
+// shorter slice into its parent, so other references to the old slice are
+// not affected. The error wraps ErrInvalidPath if p is the zero Path.
+func (p Path) Unset(m map[string]any) (bool, error) {
+	if len(p.segs) == 0 {
+		return false, fmt.Errorf("%w: empty path", ErrInvalidPath)
+	}
+	_, ok := unsetPath(m, p.segs)
+	return ok, nil
+}
+
+func getSegment(seg keypath.Segment, cur any) (any, bool) {
+	if seg.IsIndex {
+		s, ok := cur.([]any)
+		if !ok || seg.Index >= len(s) {
+			return nil, false
+		}
+		return s[seg.Index], true
+	}
+	m, ok := cur.(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	v, ok := m[seg.Key]
+	return v, ok
+}
+
+func setPath(cur any, segs []keypath.Segment, v any) (any, error) {
+	if len(segs) == 0 {
+		return v, nil
+	}
+	seg := segs[0]
+
+	if seg.IsIndex {
+		s, ok := cur.([]any)
+		if !ok && cur != nil {
+			return nil, ErrTypeMismatch
+		}
+		if seg.Index > len(s) {
+			return nil, ErrPathNotFound
+		}
+		var next any
+		if seg.Index < len(s) {
+			next = s[seg.Index]
+		}
+		child, err := setPath(next, segs[1:], v)
+		if err != nil {
+			return nil, err
+		}
+		if seg.Index == len(s) {
+			return append(s, child), nil
+		}
+		s[seg.Index] = child
+		return s, nil
+	}
+
+	m, ok := cur.(map[string]any)
+	if !ok && cur != nil {
+		return nil, ErrTypeMismatch
+	}
+	child, err := setPath(m[seg.Key], segs[1:], v)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil {
+		m = map[string]any{}
+	}
+	m[seg.Key] = child
+	return m, nil
+}
+
+func unsetPath(cur any, segs []keypath.Segment) (any, bool) {
+	if len(segs) == 0 {
+		return cur, false
+	}
+	seg := segs[0]
+	next, ok := getSegment(seg, cur)
+	if !ok {
+		return cur, false
+	}
+
+	if len(segs) == 1 {
+		if seg.IsIndex {
+			s := cur.([]any)
+			n := make([]any, 0, len(s)-1)
+			n = append(n, s[:seg.Index]...)
+			return append(n, s[seg.Index+1:]...), true
+		}
+		delete(cur.(map[string]any), seg.Key)
+		return cur, true
+	}
+
+	child, ok := unsetPath(next, segs[1:])
+	if !ok {
+		return cur, false
+	}
+	if seg.IsIndex {
+		cur.([]any)[seg.Index] = child
+	} else {
+		cur.(map[string]any)[seg.Key] = child
+	}
+	return cur, true
+}
+
+func toInt(v any) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int8:
+		return int(n), true
+	case int16:
+		return int(n), true
+	case int32:
+		return int(n), true
+	case int64:
+		return int(n), int64(int(n)) == n
+	case uint:
+		return int(n), n <= math.MaxInt
+	case uint8:
+		return int(n), true
+	case uint16:
+		return int(n), true
+	case uint32:
+		return int(n), uint64(n) <= math.MaxInt
+	case uint64:
+		return int(n), n <= math.MaxInt
+	case float32:
+		return toInt(float64(n))
+	case float64:
+		if n != math.Trunc(n) || n < math.MinInt || n >= math.MaxInt {
+			return 0, false
+		}
+		return int(n), true
+	case json.Number:
+		i, err := n.Int64()
+		if err != nil {
+			return 0, false
+		}
+		return toInt(i)
+	}
+	return 0, false
+}
+
+// Get returns the value at path in m. See Path.Get.
+func Get(m map[string]any, path string) (any, error) {
+	p, err := ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+	return p.Get(m)
+}
+
+// GetOr returns the value at path in m, or def if there is none or path is
+// invalid.
+func GetOr(m map[string]any, path string, def any) any {
+	v, err := Get(m, path)
+	if err != nil {
+		return def
+	}
+	return v
+}
+
+// GetString returns the string at path in m. See Path.GetString.
+func GetString(m map[string]any, path string) (string, error) {
+	p, err := ParsePath(path)
+	if err != nil {
+		return "", err
+	}
+	return p.GetString(m)
+}
+
+// GetInt returns the integer at path in m. See Path.GetInt.
+func GetInt(m map[string]any, path string) (int, error) {
+	p, err := ParsePath(path)
+	if err != nil {
+		return 0, err
+	}
+	return p.GetInt(m)
+}
+
+// Has reports whether there is a value at path in m. It returns false if
+// path is invalid.
+func Has(m map[string]any, path string) bool {
+	p, err := ParsePath(path)
+	return err == nil && p.Has(m)
+}
+
+// Set stores v at path in m. See Path.Set.
+func Set(m map[string]any, path string, v any) error {
+	p, err := ParsePath(path)
+	if err != nil {
+		return err
+	}
+	return p.Set(m, v)
+}
+
+// Unset removes the value at path from m and reports whether there was one.
+// See Path.Unset.
+func Unset(m map[string]any, path string) (bool, error) {
+	p, err := ParsePath(path)
+	if err != nil {
+		return false, err
+	}
+	return p.Unset(m)
+}
@@ -0,0 +1,323 @@
+package maps
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func pathTestData() map[string]any {
+	return map[string]any{
+		"a": map[string]any{
+			"b": []any{
+				"x",
+				"y",
+				map[string]any{"c": 3.0},
+			},
+			"d.e": "dotted",
+		},
+		"name": "go-dash",
+		"n":    json.Number("42"),
+	}
+}
+
+func TestParsePath(t *testing.T) {
+	{
+		inputs := map[string]string{
+			"a":            "a",
+			"a.b[2].c":     "a.b[2].c",
+			"[0][1]":       "[0][1]",
+			`a["d.e"]`:     `a["d.e"]`,
+			`a['d.e']`:     `a["d.e"]`,
+			`a["q\"\\"]`:   `a["q\"\\"]`,
+			`a["plain"].b`: "a.plain.b",
+			`[""]`:         `[""]`,
+		}
+
+		for input, expect := range inputs {
+			p, err := ParsePath(input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(expect, p.String()); diff != "" {
+				t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+			}
+		}
+	}
+	{
+		inputs := []string{"", ".", "a.", ".a", "a..b", "a.[0]", "a[0]b", "a[", "a[]", "a[x]", "a[-1]", `a["b]`, `a["b"`, "a]"}
+
+		for _, input := range inputs {
+			if _, err := ParsePath(input); !errors.Is(err, ErrInvalidPath) {
+				t.Errorf("error is missmatch for %q: %v", input, err)
+			}
+		}
+	}
+}
+
+func TestGet(t *testing.T) {
+	m := pathTestData()
+
+	{
+		inputs := map[string]any{
+			"a.b[2].c": 3.0,
+			"a.b[0]":   "x",
+			`a["d.e"]`: "dotted",
+			"name":     "go-dash",
+		}
+
+		for input, expect := range inputs {
+			output, err := Get(m, input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(expect, output); diff != "" {
+				t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+			}
+		}
+	}
+	{
+		inputs := []string{"x", "a.b[3]", "a.b.c", "name[0]", "a.b[0].c"}
+
+		for _, input := range inputs {
+			if _, err := Get(m, input); !errors.Is(err, ErrPathNotFound) {
+				t.Errorf("error is missmatch for %q: %v", input, err)
+			}
+		}
+	}
+	{
+		if diff := cmp.Diff(any("none"), GetOr(m, "a.x", "none")); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff(any("x"), GetOr(m, "a.b[0]", "none")); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestGetString(t *testing.T) {
+	m := pathTestData()
+
+	{
+		output, err := GetString(m, "name")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff("go-dash", output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		if _, err := GetString(m, "a.b[2].c"); !errors.Is(err, ErrTypeMismatch) {
+			t.Errorf("error is missmatch: %v", err)
+		}
+		if _, err := GetString(m, "missing"); !errors.Is(err, ErrPathNotFound) {
+			t.Errorf("error is missmatch: %v", err)
+		}
+	}
+}
+
+func TestGetInt(t *testing.T) {
+	{
+		m := pathTestData()
+		inputs := map[string]int{"a.b[2].c": 3, "n": 42}
+
+		for input, expect := range inputs {
+			output, err := GetInt(m, input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(expect, output); diff != "" {
+				t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+			}
+		}
+	}
+	{
+		m := map[string]any{"f": 1.5, "s": "1", "big": 1e300, "u": uint64(1 << 63)}
+
+		for _, input := range []string{"f", "s", "big", "u"} {
+			if _, err := GetInt(m, input); !errors.Is(err, ErrTypeMismatch) {
+				t.Errorf("error is missmatch for %q: %v", input, err)
+			}
+		}
+	}
+}
+
+func TestHas(t *testing.T) {
+	m := pathTestData()
+
+	{
+		if !Has(m, "a.b[1]") {
+			t.Errorf("result is missmatch: %v", false)
+		}
+		if Has(m, "a.b[5]") {
+			t.Errorf("result is missmatch: %v", true)
+		}
+		if Has(m, "a..b") {
+			t.Errorf("result is missmatch: %v", true)
+		}
+	}
+}
+
+func TestSet(t *testing.T) {
+	{
+		m := pathTestData()
+		expect := pathTestData()
+		expect["a"].(map[string]any)["b"].([]any)[2].(map[string]any)["c"] = 4
+		expect["x"] = map[string]any{"y": []any{map[string]any{"z": true}}}
+
+		if err := Set(m, "a.b[2].c", 4); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := Set(m, "x.y[0].z", true); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(expect, m); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		m := pathTestData()
+		expect := []any{"x", "y", map[string]any{"c": 3.0}, "w"}
+
+		if err := Set(m, "a.b[3]", "w"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(expect, m["a"].(map[string]any)["b"]); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		m := pathTestData()
+
+		for _, input := range []string{"a.b[5]", "a.b[99999999999999]", "x[1000000000]", "x.y[1].z"} {
+			if err := Set(m, input, "w"); !errors.Is(err, ErrPathNotFound) {
+				t.Errorf("error is missmatch for %q: %v", input, err)
+			}
+		}
+		if diff := cmp.Diff(pathTestData(), m); diff != "" {
+			t.Errorf("input is modified (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		m := pathTestData()
+
+		if err := Set(m, "name.first", "go"); !errors.Is(err, ErrTypeMismatch) {
+			t.Errorf("error is missmatch: %v", err)
+		}
+		if err := Set(m, "a[0]", "go"); !errors.Is(err, ErrTypeMismatch) {
+			t.Errorf("error is missmatch: %v", err)
+		}
+		if err := Set(m, "new.name.first", "go"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := Set(m, "a.", "go"); !errors.Is(err, ErrInvalidPath) {
+			t.Errorf("error is missmatch: %v", err)
+		}
+	}
+	{
+		m := pathTestData()
+		expect := pathTestData()
+
+		if err := Set(m, "x.name.first", "go"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := Set(m, "x.name[0]", "go"); !errors.Is(err, ErrTypeMismatch) {
+			t.Errorf("error is missmatch: %v", err)
+		}
+		expect["x"] = map[string]any{"name": map[string]any{"first": "go"}}
+		if diff := cmp.Diff(expect, m); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		m := pathTestData()
+
+		if err := (Path{}).Set(m, "go"); !errors.Is(err, ErrInvalidPath) {
+			t.Errorf("error is missmatch: %v", err)
+		}
+		if diff := cmp.Diff(pathTestData(), m); diff != "" {
+			t.Errorf("input is modified (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestUnset(t *testing.T) {
+	{
+		m := pathTestData()
+		expect := pathTestData()
+		delete(expect["a"].(map[string]any), "d.e")
+		expect["a"].(map[string]any)["b"] = []any{"x", map[string]any{"c": 3.0}}
+
+		inputs := []string{`a["d.e"]`, "a.b[1]", "a.b[5]", "missing.key"}
+		expects := []bool{true, true, false, false}
+
+		for i, input := range inputs {
+			output, err := Unset(m, input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output != expects[i] {
+				t.Errorf("result is missmatch for %q: %v", input, output)
+			}
+		}
+		if diff := cmp.Diff(expect, m); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		m := pathTestData()
+
+		if _, err := Unset(m, "a."); !errors.Is(err, ErrInvalidPath) {
+			t.Errorf("error is missmatch: %v", err)
+		}
+		if _, err := (Path{}).Unset(m); !errors.Is(err, ErrInvalidPath) {
+			t.Errorf("error is missmatch: %v", err)
+		}
+		if diff := cmp.Diff(pathTestData(), m); diff != "" {
+			t.Errorf("input is modified (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestUnsetSharedSlice(t *testing.T) {
+	{
+		shared := []any{1, 2, 3}
+		m := map[string]any{"a": shared}
+
+		if ok, err := Unset(m, "a[0]"); err != nil || !ok {
+			t.Errorf("result is missmatch: %v, %v", ok, err)
+		}
+		if diff := cmp.Diff([]any{2, 3}, m["a"]); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff([]any{1, 2, 3}, shared); diff != "" {
+			t.Errorf("input is modified (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestPathReuse(t *testing.T) {
+	{
+		p := MustParsePath("user.name")
+		inputs := []map[string]any{
+			{"user": map[string]any{"name": "a"}},
+			{"user": map[string]any{"name": "b"}},
+		}
+		expect := []string{"a", "b"}
+
+		output := make([]string, 0)
+		for _, m := range inputs {
+			s, err := p.GetString(m)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			output = append(output, s)
+		}
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package maps
+
+import (
+	"cmp"
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// FindSorted is like Find, but visits keys in ascending order, so it returns
+// the match with the smallest key.
+func FindSorted[K constraints.Ordered, V any](m map[K]V, pred func(K, V) bool) (K, V, bool) {
+	return FindSortedFunc(m, less[K], pred)
+}
+
+// FindSortedFunc is like FindSorted, but orders keys with less.
+func FindSortedFunc[K comparable, V any](m map[K]V, less func(K, K) bool, pred func(K, V) bool) (K, V, bool) {
+	for _, e := range sortedEntriesFunc(m, less) {
+		if pred(e.Key, e.Value) {
+			return e.Key, e.Value, true
+		}
+	}
+
+	var k K
+	var v V
+	return k, v, false
+}
+
+// ForEachSorted is like ForEach, but visits keys in ascending order.
+func ForEachSorted[K constraints.Ordered, V any](m map[K]V, f func(K, V)) {
+	ForEachSortedFunc(m, less[K], f)
+}
+
+// ForEachSortedFunc is like ForEachSorted, but orders keys with less.
+func ForEachSortedFunc[K comparable, V any](m map[K]V, less func(K, K) bool, f func(K, V)) {
+	for _, e := range sortedEntriesFunc(m, less) {
+		f(e.Key, e.Value)
+	}
+}
+
+// PartitionSorted is like Partition, but visits keys in ascending order. The
+// parts are ordered by their smallest key.
+func PartitionSorted[K constraints.Ordered, V any, U comparable](m map[K]V, f func(K, V) U) []map[K]V {
+	return PartitionSortedFunc(m, less[K], f)
+}
+
+// PartitionSortedFunc is like PartitionSorted, but orders keys with less.
+func PartitionSortedFunc[K comparable, V any, U comparable](m map[K]V, less func(K, K) bool, f func(K, V) U) []map[K]V {
+	parts := make([]map[K]V, 0)
+	index := map[U]int{}
+	for _, e := range sortedEntriesFunc(m, less) {
+		k, v := e.Key, e.Value
+		key := f(k, v)
+		i, ok := index[key]
+		if !ok {
+			i = len(parts)
+			index[key] = i
+			parts = append(parts, map[K]V{})
+		}
+		parts[i][k] = v
+	}
+	return parts
+}
+
+// ReduceSorted is like Reduce, but folds the entries in ascending key order.
+func ReduceSorted[K constraints.Ordered, V, U any](m map[K]V, f func(K, V, U) U, acc U) U {
+	return ReduceSortedFunc(m, less[K], f, acc)
+}
+
+// ReduceSortedFunc is like ReduceSorted, but orders keys with less.
+func ReduceSortedFunc[K comparable, V, U any](m map[K]V, less func(K, K) bool, f func(K, V, U) U, acc U) U {
+	for _, e := range sortedEntriesFunc(m, less) {
+		acc = f(e.Key, e.Value, acc)
+	}
+	return acc
+}
+
+// sortedEntriesFunc returns the entries of m ordered by key. Values are
+// collected while ranging over m rather than looked up by key, since a NaN key
+// can never be looked up.
+func sortedEntriesFunc[K comparable, V any](m map[K]V, less func(K, K) bool) []Entry[K, V] {
+	entries := Entries(m)
+	sort.Slice(entries, func(i, j int) bool { return less(entries[i].Key, entries[j].Key) })
+	return entries
+}
+
+func sortedKeysFunc[K comparable, V any](m map[K]V, less func(K, K) bool) []K {
+	keys := Keys(m)
+	sort.Slice(keys, func(i, j int) bool { return less(keys[i], keys[j]) })
+	return keys
+}
+
+// less orders keys like cmp.Less, so NaN keys sort first and the order is
+// strict even for floating-point keys.
+func less[K constraints.Ordered](a, b K) bool {
+	return cmp.Less(a, b)
+}
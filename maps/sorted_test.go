@@ -0,0 +1,147 @@
+package maps
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestFindSorted(t *testing.T) {
+	{
+		input := map[string]int{"a": 0, "b": 1, "c": 2, "d": 3, "e": 4}
+		f := func(_ string, v int) bool { return v%2 != 0 }
+
+		key, value, exist := FindSorted(input, f)
+		if diff := cmp.Diff(true, exist); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff(1, value); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff("b", key); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := map[string]int{"a": 0, "b": 1, "c": 2, "d": 3, "e": 4}
+		f := func(_ string, v int) bool { return v%2 != 0 }
+		desc := func(a, b string) bool { return a > b }
+
+		key, value, exist := FindSortedFunc(input, desc, f)
+		if diff := cmp.Diff(true, exist); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff(3, value); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff("d", key); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := map[string]int{"a": 0, "b": 1}
+		f := func(_ string, v int) bool { return v > 5 }
+
+		_, _, exist := FindSorted(input, f)
+		if diff := cmp.Diff(false, exist); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestSortedNaNKey(t *testing.T) {
+	input := map[float64]int{math.NaN(): 5, 1: 2}
+
+	{
+		k, v, ok := FindSorted(input, func(_ float64, v int) bool { return v == 5 })
+		if !ok || !math.IsNaN(k) || v != 5 {
+			t.Errorf("result is missmatch: %v, %v, %v", k, v, ok)
+		}
+	}
+	{
+		output := ReduceSorted(input, func(_ float64, v int, acc []int) []int {
+			return append(acc, v)
+		}, []int{})
+		if diff := cmp.Diff([]int{5, 2}, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		output := PartitionSorted(input, func(_ float64, v int) bool { return v > 3 })
+		if diff := cmp.Diff([]int{5, 2}, []int{ReduceSorted(output[0], sumValues, 0), ReduceSorted(output[1], sumValues, 0)}); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func sumValues(_ float64, v int, acc int) int {
+	return acc + v
+}
+
+func TestForEachSorted(t *testing.T) {
+	{
+		input := map[string]int{"c": 2, "a": 0, "b": 1}
+		expect := []string{"a", "b", "c"}
+
+		output := make([]string, 0)
+		ForEachSorted(input, func(k string, _ int) {
+			output = append(output, k)
+		})
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := map[float64]int{math.NaN(): 1, 2: 1, 1: 1, math.Inf(-1): 1}
+		expect := []float64{math.NaN(), math.Inf(-1), 1, 2}
+
+		output := make([]float64, 0)
+		ForEachSorted(input, func(k float64, _ int) {
+			output = append(output, k)
+		})
+		if diff := cmp.Diff(expect, output, cmpopts.EquateNaNs()); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestPartitionSorted(t *testing.T) {
+	{
+		input := map[string]int{"a": 1, "b": 2, "c": 4, "d": 5}
+		expect := []map[string]int{{"a": 1, "d": 5}, {"b": 2, "c": 4}}
+		f := func(_ string, v int) bool { return v%2 == 0 }
+
+		output := PartitionSorted(input, f)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestReduceSorted(t *testing.T) {
+	{
+		input := map[string]int{"c": 2, "a": 0, "b": 1}
+		expect := "a0b1c2"
+
+		output := ReduceSorted(input, func(k string, v int, acc string) string {
+			return acc + fmt.Sprintf("%s%d", k, v)
+		}, "")
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := map[int]string{2: "c", 0: "a", 1: "b"}
+		expect := "cba"
+
+		output := ReduceSortedFunc(input, func(a, b int) bool { return a > b }, func(_ int, v string, acc string) string {
+			return acc + v
+		}, "")
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
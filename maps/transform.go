@@ -0,0 +1,96 @@
+package maps
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrKeyCollision is returned by MapKeys and MapEntries under
+// FailOnCollision when two entries produce the same key.
+var ErrKeyCollision = errors.New("maps: key collision")
+
+// CollisionPolicy selects how MapKeys and MapEntries resolve several entries
+// that produce the same key. First and last refer to the order in which the
+// source entries are visited; the *Func variants visit them in ascending key
+// order, so their result does not depend on map iteration order.
+type CollisionPolicy int
+
+const (
+	// KeepLast keeps the value of the last colliding entry visited.
+	KeepLast CollisionPolicy = iota
+	// KeepFirst keeps the value of the first colliding entry visited.
+	KeepFirst
+	// FailOnCollision stops and returns an error wrapping ErrKeyCollision.
+	FailOnCollision
+)
+
+// Invert returns a new map with the keys and values of m swapped. If several
+// keys share a value, which of them is kept is unspecified.
+func Invert[K, V comparable](m map[K]V) map[V]K {
+	n := make(map[V]K, len(m))
+	for k, v := range m {
+		n[v] = k
+	}
+	return n
+}
+
+// InvertBy groups the keys of m by the key generated by f. The order of keys
+// within each group is unspecified.
+func InvertBy[K comparable, V any, U comparable](m map[K]V, f func(K, V) U) map[U][]K {
+	n := map[U][]K{}
+	for k, v := range m {
+		key := f(k, v)
+		n[key] = append(n[key], k)
+	}
+	return n
+}
+
+// MapEntries returns a new map holding the key and value generated by conv for
+// each entry of m. Colliding keys are resolved according to policy. Entries
+// are visited in map iteration order, so KeepFirst and KeepLast are only
+// deterministic through MapEntriesFunc.
+func MapEntries[K comparable, V any, J comparable, W any](m map[K]V, conv func(K, V) (J, W), policy CollisionPolicy) (map[J]W, error) {
+	return mapEntries(Entries(m), conv, policy)
+}
+
+// MapEntriesFunc is like MapEntries, but visits entries in ascending key
+// order as determined by less, which KeepFirst and KeepLast refer to.
+func MapEntriesFunc[K comparable, V any, J comparable, W any](m map[K]V, less func(K, K) bool, conv func(K, V) (J, W), policy CollisionPolicy) (map[J]W, error) {
+	if policy == FailOnCollision {
+		return mapEntries(Entries(m), conv, policy)
+	}
+	return mapEntries(sortedEntriesFunc(m, less), conv, policy)
+}
+
+// MapKeys returns a new map with each key of m replaced by the one generated
+// by conv. Colliding keys are resolved according to policy, as in MapEntries.
+func MapKeys[K comparable, V any, J comparable](m map[K]V, conv func(K, V) J, policy CollisionPolicy) (map[J]V, error) {
+	return MapEntries(m, keyConv(conv), policy)
+}
+
+// MapKeysFunc is like MapKeys, but visits entries in ascending key order as
+// determined by less, which KeepFirst and KeepLast refer to.
+func MapKeysFunc[K comparable, V any, J comparable](m map[K]V, less func(K, K) bool, conv func(K, V) J, policy CollisionPolicy) (map[J]V, error) {
+	return MapEntriesFunc(m, less, keyConv(conv), policy)
+}
+
+func keyConv[K, V any, J comparable](conv func(K, V) J) func(K, V) (J, V) {
+	return func(k K, v V) (J, V) { return conv(k, v), v }
+}
+
+func mapEntries[K comparable, V any, J comparable, W any](entries []Entry[K, V], conv func(K, V) (J, W), policy CollisionPolicy) (map[J]W, error) {
+	n := make(map[J]W, len(entries))
+	for _, e := range entries {
+		j, w := conv(e.Key, e.Value)
+		if _, ok := n[j]; ok {
+			switch policy {
+			case KeepFirst:
+				continue
+			case FailOnCollision:
+				return nil, fmt.Errorf("%w: %v", ErrKeyCollision, j)
+			}
+		}
+		n[j] = w
+	}
+	return n, nil
+}
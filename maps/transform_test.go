@@ -0,0 +1,132 @@
+package maps
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestInvert(t *testing.T) {
+	{
+		input := map[string]int{"a": 0, "b": 1, "c": 2}
+		expect := map[int]string{0: "a", 1: "b", 2: "c"}
+
+		output := Invert(input)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestInvertBy(t *testing.T) {
+	{
+		input := map[string]int{"a": 0, "b": 1, "c": 2, "d": 3}
+		expect := map[string][]string{"even": {"a", "c"}, "odd": {"b", "d"}}
+		f := func(_ string, v int) string {
+			if v%2 == 0 {
+				return "even"
+			}
+			return "odd"
+		}
+
+		output := InvertBy(input, f)
+		for _, keys := range output {
+			sort.Strings(keys)
+		}
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestMapEntries(t *testing.T) {
+	{
+		input := map[string]int{"a": 0, "b": 1}
+		expect := map[int]string{0: "A", 1: "B"}
+
+		output, err := MapEntries(input, func(k string, v int) (int, string) {
+			return v, strings.ToUpper(k)
+		}, FailOnCollision)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestMapKeys(t *testing.T) {
+	upper := func(k string, _ int) string { return strings.ToUpper(k) }
+
+	{
+		input := map[string]int{"a": 0, "b": 1}
+		expect := map[string]int{"A": 0, "B": 1}
+
+		output, err := MapKeys(input, upper, FailOnCollision)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := map[string]int{"a": 0, "A": 1, "b": 2}
+
+		output, err := MapKeys(input, upper, FailOnCollision)
+		if !errors.Is(err, ErrKeyCollision) {
+			t.Errorf("error is missmatch: %v", err)
+		}
+		if output != nil {
+			t.Errorf("result is missmatch: %v", output)
+		}
+	}
+	{
+		input := map[string]int{"a": 0, "A": 1, "b": 2}
+		expects := map[CollisionPolicy]map[string]int{
+			KeepFirst: {"A": 1, "B": 2},
+			KeepLast:  {"A": 0, "B": 2},
+		}
+
+		for policy, expect := range expects {
+			output, err := MapKeysFunc(input, func(a, b string) bool { return a < b }, upper, policy)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(expect, output); diff != "" {
+				t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+			}
+		}
+	}
+	{
+		type point struct{ X, Y int }
+		input := map[point]string{{1, 2}: "a", {3, 4}: "b"}
+		expect := map[int]string{3: "a", 7: "b"}
+
+		output, err := MapKeys(input, func(k point, _ string) int { return k.X + k.Y }, FailOnCollision)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := map[float64]int{math.NaN(): 0, 1: 1, 2: 2}
+		expect := map[bool]int{true: 0, false: 2}
+		isNaN := func(k float64, _ int) bool { return math.IsNaN(k) }
+
+		output, err := MapKeysFunc(input, less[float64], isNaN, KeepLast)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
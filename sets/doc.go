@@ -0,0 +1,2 @@
+// Package sets provides a generic Set type backed by a Go map.
+package sets
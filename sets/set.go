@@ -0,0 +1,179 @@
+package sets
+
+import (
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Set is a collection of distinct values with O(1) membership tests. It keeps
+// values in the order they were added; Values and the set operations follow
+// that order, so results are reproducible. The zero value is an empty set
+// ready to use.
+type Set[T comparable] struct {
+	items map[T]*setEntry[T]
+	order []*setEntry[T]
+}
+
+// setEntry is shared by items and order, so Remove can compact order without
+// looking values up again. That keeps values that are not equal to themselves,
+// such as NaN, consistent between the two.
+type setEntry[T any] struct {
+	value   T
+	removed bool
+}
+
+// New returns a set holding the given values.
+func New[T comparable](values ...T) *Set[T] {
+	s := &Set[T]{items: make(map[T]*setEntry[T], len(values))}
+	s.Add(values...)
+	return s
+}
+
+// FromSlice returns a set holding the elements of ary.
+func FromSlice[T comparable](ary []T) *Set[T] {
+	return New(ary...)
+}
+
+// Sorted returns the values of s in ascending order.
+func Sorted[T constraints.Ordered](s *Set[T]) []T {
+	out := make([]T, 0, s.Len())
+	for v := range s.items {
+		out = append(out, v)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+// Add adds values to s. Values already present keep their original position.
+func (s *Set[T]) Add(values ...T) {
+	if s.items == nil {
+		s.items = map[T]*setEntry[T]{}
+	}
+	for _, v := range values {
+		if _, ok := s.items[v]; !ok {
+			e := &setEntry[T]{value: v}
+			s.items[v] = e
+			s.order = append(s.order, e)
+		}
+	}
+}
+
+// Clone returns a copy of s.
+func (s *Set[T]) Clone() *Set[T] {
+	n := &Set[T]{items: make(map[T]*setEntry[T], s.Len())}
+	n.Add(s.Values()...)
+	return n
+}
+
+// Difference returns a new set of the values of s that are not in o.
+func (s *Set[T]) Difference(o *Set[T]) *Set[T] {
+	n := New[T]()
+	for _, e := range s.order {
+		if !o.Has(e.value) {
+			n.Add(e.value)
+		}
+	}
+	return n
+}
+
+// Equal reports whether s and o hold the same values.
+func (s *Set[T]) Equal(o *Set[T]) bool {
+	return s.Len() == o.Len() && s.IsSubset(o)
+}
+
+// Has reports whether v is in s.
+func (s *Set[T]) Has(v T) bool {
+	_, ok := s.items[v]
+	return ok
+}
+
+// Intersection returns a new set of the values present in both s and o.
+func (s *Set[T]) Intersection(o *Set[T]) *Set[T] {
+	n := New[T]()
+	for _, e := range s.order {
+		if o.Has(e.value) {
+			n.Add(e.value)
+		}
+	}
+	return n
+}
+
+// IsSubset reports whether every value of s is also in o.
+func (s *Set[T]) IsSubset(o *Set[T]) bool {
+	if s.Len() > o.Len() {
+		return false
+	}
+	for v := range s.items {
+		if !o.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset reports whether every value of o is also in s.
+func (s *Set[T]) IsSuperset(o *Set[T]) bool {
+	return o.IsSubset(s)
+}
+
+// Len returns the number of values in s.
+func (s *Set[T]) Len() int {
+	return len(s.items)
+}
+
+// Remove removes values from s. Values not present are ignored. The order of
+// the remaining values is compacted once per call, in O(Len) time.
+func (s *Set[T]) Remove(values ...T) {
+	removed := false
+	for _, v := range values {
+		if e, ok := s.items[v]; ok {
+			e.removed = true
+			delete(s.items, v)
+			removed = true
+		}
+	}
+	if !removed {
+		return
+	}
+
+	j := 0
+	for _, e := range s.order {
+		if !e.removed {
+			s.order[j] = e
+			j++
+		}
+	}
+	clear(s.order[j:])
+	s.order = s.order[:j]
+}
+
+// SymmetricDifference returns a new set of the values present in exactly one
+// of s and o.
+func (s *Set[T]) SymmetricDifference(o *Set[T]) *Set[T] {
+	n := s.Difference(o)
+	for _, e := range o.order {
+		if !s.Has(e.value) {
+			n.Add(e.value)
+		}
+	}
+	return n
+}
+
+// Union returns a new set of the values present in s or o.
+func (s *Set[T]) Union(o *Set[T]) *Set[T] {
+	n := s.Clone()
+	for _, e := range o.order {
+		n.Add(e.value)
+	}
+	return n
+}
+
+// Values returns the values of s in the order they were added.
+func (s *Set[T]) Values() []T {
+	out := make([]T, len(s.order))
+	for i, e := range s.order {
+		out[i] = e.value
+	}
+	return out
+}
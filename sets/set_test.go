@@ -0,0 +1,167 @@
+package sets
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestAdd(t *testing.T) {
+	{
+		var s Set[string]
+		s.Add("b", "a", "b", "c")
+		expect := []string{"b", "a", "c"}
+
+		output := s.Values()
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff(3, s.Len()); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestRemove(t *testing.T) {
+	{
+		s := New(0, 1, 2, 3)
+		s.Remove(1, 3, 5)
+		expect := []int{0, 2}
+
+		output := s.Values()
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if s.Has(1) {
+			t.Errorf("removed value is still present")
+		}
+	}
+	{
+		s := New("a", "b", "c", "d")
+		s.Remove("b")
+		s.Add("e", "b")
+		s.Remove("a")
+		expect := []string{"c", "d", "e", "b"}
+
+		output := s.Union(New("f", "c")).Values()
+		if diff := cmp.Diff(append(expect, "f"), output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		output = s.Values()
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		s := New(math.NaN(), 1, 2)
+		s.Remove(1)
+
+		output := s.Values()
+		if diff := cmp.Diff([]float64{math.NaN(), 2}, output, cmpopts.EquateNaNs()); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff(2, s.Len()); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestSorted(t *testing.T) {
+	{
+		input := FromSlice([]int{3, 1, 2, 1})
+		expect := []int{1, 2, 3}
+
+		output := Sorted(input)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestDifference(t *testing.T) {
+	{
+		input1 := New(3, 1, 2)
+		input2 := New(2, 4)
+		expect := []int{3, 1}
+
+		output := input1.Difference(input2).Values()
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestIntersection(t *testing.T) {
+	{
+		input1 := New(3, 1, 2)
+		input2 := New(2, 4, 3)
+		expect := []int{3, 2}
+
+		output := input1.Intersection(input2).Values()
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestSymmetricDifference(t *testing.T) {
+	{
+		input1 := New(3, 1, 2)
+		input2 := New(2, 4, 3)
+		expect := []int{1, 4}
+
+		output := input1.SymmetricDifference(input2).Values()
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestUnion(t *testing.T) {
+	{
+		input1 := New(3, 1)
+		input2 := New(2, 1, 0)
+		expect := []int{3, 1, 2, 0}
+
+		output := input1.Union(input2).Values()
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff([]int{3, 1}, input1.Values()); diff != "" {
+			t.Errorf("receiver is modified (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestIsSubset(t *testing.T) {
+	{
+		input1 := New(1, 2)
+		input2 := New(0, 1, 2)
+
+		if !input1.IsSubset(input2) {
+			t.Errorf("%v is not a subset of %v", input1.Values(), input2.Values())
+		}
+		if input2.IsSubset(input1) {
+			t.Errorf("%v is a subset of %v", input2.Values(), input1.Values())
+		}
+		if !input2.IsSuperset(input1) {
+			t.Errorf("%v is not a superset of %v", input2.Values(), input1.Values())
+		}
+	}
+}
+
+func TestEqual(t *testing.T) {
+	{
+		input1 := New(1, 2, 3)
+		input2 := New(3, 2, 1)
+
+		if !input1.Equal(input2) {
+			t.Errorf("%v is not equal to %v", input1.Values(), input2.Values())
+		}
+		if input1.Equal(New(1, 2)) {
+			t.Errorf("sets of different size are equal")
+		}
+	}
+}
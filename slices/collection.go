@@ -1,6 +1,18 @@
 package slices
 
-func countBy[T any, U comparable](ary []T, f func(T) U) map[U]int {
+import (
+	"errors"
+	"fmt"
+
+	"go-dash/lang"
+)
+
+// ErrDuplicateKey is returned by KeyByStrict when two elements produce the
+// same key.
+var ErrDuplicateKey = errors.New("slices: duplicate key")
+
+// CountBy returns the number of elements of ary for each key generated by f.
+func CountBy[T any, U comparable](ary []T, f func(T) U) map[U]int {
 	group := map[U]int{}
 	for _, a := range ary {
 		group[f(a)] += 1
@@ -8,7 +20,9 @@ func countBy[T any, U comparable](ary []T, f func(T) U) map[U]int {
 	return group
 }
 
-func every[T any](ary []T, pred func(T) bool) bool {
+// Every reports whether pred returns true for all elements of ary.
+// It returns true for an empty slice.
+func Every[T any](ary []T, pred func(T) bool) bool {
 	for _, a := range ary {
 		if !pred(a) {
 			return false
@@ -17,7 +31,8 @@ func every[T any](ary []T, pred func(T) bool) bool {
 	return true
 }
 
-func filter[T any](ary []T, pred func(T) bool) []T {
+// Filter returns a new slice of the elements of ary for which pred returns true.
+func Filter[T any](ary []T, pred func(T) bool) []T {
 	n := make([]T, 0)
 	for _, a := range ary {
 		if pred(a) {
@@ -27,7 +42,9 @@ func filter[T any](ary []T, pred func(T) bool) []T {
 	return n
 }
 
-func find[T any](ary []T, pred func(T) bool) (T, bool) {
+// Find returns the first element of ary for which pred returns true.
+// The boolean result reports whether such an element was found.
+func Find[T any](ary []T, pred func(T) bool) (T, bool) {
 	for _, a := range ary {
 		if pred(a) {
 			return a, true
@@ -38,7 +55,8 @@ func find[T any](ary []T, pred func(T) bool) (T, bool) {
 	return v, false
 }
 
-func findLast[T any](ary []T, pred func(T) bool) (T, bool) {
+// FindLast is like Find, but iterates over ary from right to left.
+func FindLast[T any](ary []T, pred func(T) bool) (T, bool) {
 	for i := len(ary); i > 0; i-- {
 		a := ary[i-1]
 		if pred(a) {
@@ -50,19 +68,23 @@ func findLast[T any](ary []T, pred func(T) bool) (T, bool) {
 	return v, false
 }
 
-func forEach[T any](ary []T, f func(T)) {
+// ForEach calls f for each element of ary in order.
+func ForEach[T any](ary []T, f func(T)) {
 	for _, a := range ary {
 		f(a)
 	}
 }
 
-func forEachRight[T any](ary []T, f func(T)) {
+// ForEachRight is like ForEach, but iterates over ary from right to left.
+func ForEachRight[T any](ary []T, f func(T)) {
 	for i := len(ary); i > 0; i-- {
 		f(ary[i-1])
 	}
 }
 
-func groupBy[T any, U comparable](ary []T, f func(T) U) map[U][]T {
+// GroupBy groups the elements of ary by the key generated by f.
+// The order of elements within each group follows ary.
+func GroupBy[T any, U comparable](ary []T, f func(T) U) map[U][]T {
 	group := map[U][]T{}
 	for _, a := range ary {
 		key := f(a)
@@ -74,7 +96,8 @@ func groupBy[T any, U comparable](ary []T, f func(T) U) map[U][]T {
 	return group
 }
 
-func includes[T comparable](ary []T, v T) bool {
+// Includes reports whether v is present in ary.
+func Includes[T comparable](ary []T, v T) bool {
 	for _, a := range ary {
 		if a == v {
 			return true
@@ -83,7 +106,33 @@ func includes[T comparable](ary []T, v T) bool {
 	return false
 }
 
-func mapBy[T, U any](ary []T, conv func(T) U) []U {
+// KeyBy returns a map from the key generated by f to each element of ary.
+// If several elements share a key, the last one wins.
+func KeyBy[T any, K comparable](ary []T, f func(T) K) map[K]T {
+	n := make(map[K]T, len(ary))
+	for _, a := range ary {
+		n[f(a)] = a
+	}
+	return n
+}
+
+// KeyByStrict is like KeyBy, but fails if several elements share a key. The
+// error is an *IndexError for the first repeated element, wrapping
+// ErrDuplicateKey.
+func KeyByStrict[T any, K comparable](ary []T, f func(T) K) (map[K]T, error) {
+	n := make(map[K]T, len(ary))
+	for i, a := range ary {
+		key := f(a)
+		if _, ok := n[key]; ok {
+			return nil, &IndexError{Index: i, Err: fmt.Errorf("%w: %v", ErrDuplicateKey, key)}
+		}
+		n[key] = a
+	}
+	return n, nil
+}
+
+// Map returns a new slice holding the result of conv for each element of ary.
+func Map[T, U any](ary []T, conv func(T) U) []U {
 	n := make([]U, len(ary), cap(ary))
 	for i, a := range ary {
 		n[i] = conv(a)
@@ -91,30 +140,44 @@ func mapBy[T, U any](ary []T, conv func(T) U) []U {
 	return n
 }
 
-func partition[T any, U comparable](ary []T, f func(T) U) [][]T {
+// Partition splits ary into groups of elements that share the key generated by f.
+// The order of the groups is unspecified.
+func Partition[T any, U comparable](ary []T, f func(T) U) [][]T {
 	parts := make([][]T, 0)
-	group := groupBy(ary, f)
+	group := GroupBy(ary, f)
 	for _, v := range group {
 		parts = append(parts, v)
 	}
 	return parts
 }
 
-func reduce[T, U any](ary []T, f func(T, U) U, acc U) U {
+// Pluck returns the value of field for each element of ary. field is a path
+// as accepted by lang.Property, such as "Email" or "address.city", and
+// matches struct fields by their `json` tag name or Go name. An element
+// without such a value, or whose value is not a U, yields the zero value of
+// U. Pluck panics if field cannot be parsed.
+func Pluck[T, U any](ary []T, field string) []U {
+	return Map(ary, lang.Property[T, U](field))
+}
+
+// Reduce folds ary from left to right into acc using f.
+func Reduce[T, U any](ary []T, f func(T, U) U, acc U) U {
 	for _, a := range ary {
 		acc = f(a, acc)
 	}
 	return acc
 }
 
-func reduceRight[T, U any](ary []T, f func(T, U) U, acc U) U {
+// ReduceRight is like Reduce, but folds ary from right to left.
+func ReduceRight[T, U any](ary []T, f func(T, U) U, acc U) U {
 	for i := len(ary); i > 0; i-- {
 		acc = f(ary[i-1], acc)
 	}
 	return acc
 }
 
-func some[T any](ary []T, pred func(T) bool) bool {
+// Some reports whether pred returns true for any element of ary.
+func Some[T any](ary []T, pred func(T) bool) bool {
 	for _, a := range ary {
 		if pred(a) {
 			return true
@@ -123,7 +186,9 @@ func some[T any](ary []T, pred func(T) bool) bool {
 	return false
 }
 
-func reject[T any](ary []T, pred func(T) bool) []T {
+// Reject is the opposite of Filter; it returns the elements of ary for which pred
+// returns false.
+func Reject[T any](ary []T, pred func(T) bool) []T {
 	ret := make([]T, 0, cap(ary))
 	for _, a := range ary {
 		if !pred(a) {
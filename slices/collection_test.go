@@ -1,6 +1,7 @@
 package slices
 
 import (
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
@@ -24,7 +25,7 @@ func TestCountBy(t *testing.T) {
 			}
 		}
 
-		output := countBy(input, f)
+		output := CountBy(input, f)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -42,7 +43,7 @@ func TestCountBy(t *testing.T) {
 			}
 		}
 
-		output := countBy(input, f)
+		output := CountBy(input, f)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -55,7 +56,7 @@ func TestEvery(t *testing.T) {
 		pred := func(v int) bool { return v%2 == 0 }
 		expect := true
 
-		output := every(input, pred)
+		output := Every(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -65,7 +66,7 @@ func TestEvery(t *testing.T) {
 		pred := func(v int) bool { return v%2 == 0 }
 		expect := false
 
-		output := every(input, pred)
+		output := Every(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -77,7 +78,7 @@ func TestFilter(t *testing.T) {
 		input := []int{0, 1, 2, 3, 4, 5}
 		expect := []int{0, 2, 4}
 
-		output := filter(input, func(v int) bool { return v%2 == 0 })
+		output := Filter(input, func(v int) bool { return v%2 == 0 })
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -86,7 +87,7 @@ func TestFilter(t *testing.T) {
 		input := []string{"a1", "b1", "a2", "b2", "a3", "b3"}
 		expect := []string{"a1", "a2", "a3"}
 
-		output := filter(input, func(v string) bool {
+		output := Filter(input, func(v string) bool {
 			return strings.HasPrefix(v, "a")
 		})
 		if diff := cmp.Diff(expect, output); diff != "" {
@@ -98,7 +99,7 @@ func TestFilter(t *testing.T) {
 func TestFind(t *testing.T) {
 	{
 		input := []int{0, 1, 2, 3, 4, 5}
-		output, exist := find(input, func(v int) bool { return v%2 != 0 })
+		output, exist := Find(input, func(v int) bool { return v%2 != 0 })
 		if diff := cmp.Diff(true, exist); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -108,7 +109,7 @@ func TestFind(t *testing.T) {
 	}
 	{
 		input := []int{0, 1, 2, 3, 4, 5}
-		_, exist := find(input, func(v int) bool { return v%2 == 3 })
+		_, exist := Find(input, func(v int) bool { return v%2 == 3 })
 		if diff := cmp.Diff(false, exist); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -118,7 +119,7 @@ func TestFind(t *testing.T) {
 func TestFindLast(t *testing.T) {
 	{
 		input := []int{0, 1, 2, 3, 4, 5}
-		output, exist := findLast(input, func(v int) bool { return v%2 == 0 })
+		output, exist := FindLast(input, func(v int) bool { return v%2 == 0 })
 		if diff := cmp.Diff(true, exist); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -128,7 +129,7 @@ func TestFindLast(t *testing.T) {
 	}
 	{
 		input := []int{0, 1, 2, 3, 4, 5}
-		_, exist := findLast(input, func(v int) bool { return v%2 == 3 })
+		_, exist := FindLast(input, func(v int) bool { return v%2 == 3 })
 		if diff := cmp.Diff(false, exist); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -140,7 +141,7 @@ func TestForEach(t *testing.T) {
 		input := []int{0, 1, 2, 3, 4, 5}
 		output := make([]int, 0, cap(input))
 
-		forEach(input, func(v int) {
+		ForEach(input, func(v int) {
 			output = append(output, v)
 		})
 		if diff := cmp.Diff(input, output); diff != "" {
@@ -151,7 +152,7 @@ func TestForEach(t *testing.T) {
 		input := []string{"a", "b", "c", "d", "e"}
 		output := make([]string, 0, cap(input))
 
-		forEach(input, func(v string) {
+		ForEach(input, func(v string) {
 			output = append(output, v)
 		})
 		if diff := cmp.Diff(input, output); diff != "" {
@@ -166,7 +167,7 @@ func TestForEachRight(t *testing.T) {
 		expect := []int{5, 4, 3, 2, 1, 0}
 		output := make([]int, 0, cap(input))
 
-		forEachRight(input, func(v int) {
+		ForEachRight(input, func(v int) {
 			output = append(output, v)
 		})
 		if diff := cmp.Diff(expect, output); diff != "" {
@@ -178,7 +179,7 @@ func TestForEachRight(t *testing.T) {
 		expect := []string{"e", "d", "c", "b", "a"}
 		output := make([]string, 0, cap(input))
 
-		forEachRight(input, func(v string) {
+		ForEachRight(input, func(v string) {
 			output = append(output, v)
 		})
 		if diff := cmp.Diff(expect, output); diff != "" {
@@ -202,7 +203,7 @@ func TestGroupBy(t *testing.T) {
 			}
 		}
 
-		output := groupBy(input, f)
+		output := GroupBy(input, f)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -220,7 +221,7 @@ func TestGroupBy(t *testing.T) {
 			}
 		}
 
-		output := groupBy(input, f)
+		output := GroupBy(input, f)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -231,7 +232,7 @@ func TestIncludes(t *testing.T) {
 	{
 		input := []int{0, 1, 2, 3, 4}
 
-		output := includes(input, 3)
+		output := Includes(input, 3)
 		if diff := cmp.Diff(true, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -239,19 +240,75 @@ func TestIncludes(t *testing.T) {
 	{
 		input := []int{0, 1, 2, 3, 4}
 
-		output := includes(input, -1)
+		output := Includes(input, -1)
 		if diff := cmp.Diff(false, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
 	}
 }
 
-func TestMapBy(t *testing.T) {
+type collectionUser struct {
+	ID      int    `json:"id"`
+	Email   string `json:"email"`
+	Address *struct {
+		City string `json:"city"`
+	} `json:"address"`
+}
+
+func TestKeyBy(t *testing.T) {
+	{
+		input := []string{"apple", "avocado", "banana"}
+		expect := map[byte]string{'a': "avocado", 'b': "banana"}
+
+		output := KeyBy(input, func(v string) byte { return v[0] })
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []collectionUser{{ID: 1, Email: "a@example.com"}, {ID: 2, Email: "b@example.com"}}
+		expect := map[int]collectionUser{1: input[0], 2: input[1]}
+
+		output := KeyBy(input, func(u collectionUser) int { return u.ID })
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestKeyByStrict(t *testing.T) {
+	{
+		input := []string{"apple", "banana"}
+		expect := map[byte]string{'a': "apple", 'b': "banana"}
+
+		output, err := KeyByStrict(input, func(v string) byte { return v[0] })
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []string{"apple", "banana", "avocado"}
+
+		output, err := KeyByStrict(input, func(v string) byte { return v[0] })
+		var ierr *IndexError
+		if !errors.Is(err, ErrDuplicateKey) || !errors.As(err, &ierr) || ierr.Index != 2 {
+			t.Errorf("error is missmatch: %v", err)
+		}
+		if output != nil {
+			t.Errorf("result is missmatch: %v", output)
+		}
+	}
+}
+
+func TestMap(t *testing.T) {
 	{
 		input := []int{0, 1, 2, 3, 4, 5}
 		expect := []int{0, 2, 4, 6, 8, 10}
 
-		output := mapBy(input, func(v int) int { return v * 2 })
+		output := Map(input, func(v int) int { return v * 2 })
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -260,7 +317,7 @@ func TestMapBy(t *testing.T) {
 		input := []int{0, 1, 2, 3, 4, 5}
 		expect := []string{"0", "1", "2", "3", "4", "5"}
 
-		output := mapBy(input, func(v int) string {
+		output := Map(input, func(v int) string {
 			return fmt.Sprintf("%d", v)
 		})
 		if diff := cmp.Diff(expect, output); diff != "" {
@@ -291,7 +348,7 @@ func TestPartition(t *testing.T) {
 			}
 		}
 
-		output := partition(input, f)
+		output := Partition(input, f)
 		if diff := cmp.Diff(expect, output, trans); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -307,20 +364,63 @@ func TestPartition(t *testing.T) {
 			}
 		}
 
-		output := partition(input, f)
+		output := Partition(input, f)
 		if diff := cmp.Diff(expect, output, trans); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
 	}
 }
 
+func TestPluck(t *testing.T) {
+	input := []collectionUser{
+		{ID: 1, Email: "a@example.com", Address: &struct {
+			City string `json:"city"`
+		}{City: "tokyo"}},
+		{ID: 2, Email: "b@example.com"},
+	}
+
+	{
+		expect := []string{"a@example.com", "b@example.com"}
+
+		output := Pluck[collectionUser, string](input, "email")
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		expect := []int{1, 2}
+
+		output := Pluck[collectionUser, int](input, "ID")
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		expect := []string{"tokyo", ""}
+
+		output := Pluck[collectionUser, string](input, "address.city")
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []map[string]any{{"name": "a"}, {"name": 1}, {}}
+		expect := []string{"a", "", ""}
+
+		output := Pluck[map[string]any, string](input, "name")
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
 func TestReduce(t *testing.T) {
 	{
 		input := []string{"0", "1", "2", "3", "4"}
 		expect := "01234"
 		f := func(v, acc string) string { return acc + v }
 
-		output := reduce(input, f, "")
+		output := Reduce(input, f, "")
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -330,7 +430,7 @@ func TestReduce(t *testing.T) {
 		expect := "01234"
 		f := func(v int, acc string) string { return acc + fmt.Sprintf("%d", v) }
 
-		output := reduce(input, f, "")
+		output := Reduce(input, f, "")
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -343,7 +443,7 @@ func TestReduceRight(t *testing.T) {
 		expect := "43210"
 		f := func(v, acc string) string { return acc + v }
 
-		output := reduceRight(input, f, "")
+		output := ReduceRight(input, f, "")
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -353,7 +453,7 @@ func TestReduceRight(t *testing.T) {
 		expect := "43210"
 		f := func(v int, acc string) string { return acc + fmt.Sprintf("%d", v) }
 
-		output := reduceRight(input, f, "")
+		output := ReduceRight(input, f, "")
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -366,7 +466,7 @@ func TestSome(t *testing.T) {
 		pred := func(v int) bool { return v%2 == 0 }
 		expect := true
 
-		output := some(input, pred)
+		output := Some(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -376,7 +476,7 @@ func TestSome(t *testing.T) {
 		pred := func(v int) bool { return v%2 == 0 }
 		expect := false
 
-		output := some(input, pred)
+		output := Some(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -389,7 +489,7 @@ func TestReject(t *testing.T) {
 		pred := func(v int) bool { return v%2 == 0 }
 		expect := []int{1, 3, 5}
 
-		output := reject(input, pred)
+		output := Reject(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -399,7 +499,7 @@ func TestReject(t *testing.T) {
 		pred := func(v int) bool { return v%2 == 0 }
 		expect := []int{1, 3, 5}
 
-		output := reject(input, pred)
+		output := Reject(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
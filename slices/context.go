@@ -0,0 +1,40 @@
+package slices
+
+import "context"
+
+// ForEachContext is like ForEach, but checks ctx before each element and
+// returns ctx.Err() once it is done.
+func ForEachContext[T any](ctx context.Context, ary []T, f func(T)) error {
+	for _, a := range ary {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		f(a)
+	}
+	return nil
+}
+
+// MapContext is like Map, but checks ctx before each element and returns
+// ctx.Err() once it is done.
+func MapContext[T, U any](ctx context.Context, ary []T, conv func(T) U) ([]U, error) {
+	n := make([]U, len(ary), cap(ary))
+	for i, a := range ary {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n[i] = conv(a)
+	}
+	return n, nil
+}
+
+// ReduceContext is like Reduce, but checks ctx before each element. Once ctx
+// is done, it returns the accumulator so far along with ctx.Err().
+func ReduceContext[T, U any](ctx context.Context, ary []T, f func(T, U) U, acc U) (U, error) {
+	for _, a := range ary {
+		if err := ctx.Err(); err != nil {
+			return acc, err
+		}
+		acc = f(a, acc)
+	}
+	return acc, nil
+}
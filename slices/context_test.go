@@ -0,0 +1,85 @@
+package slices
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestForEachContext(t *testing.T) {
+	{
+		input := []int{0, 1, 2, 3, 4}
+		expect := []int{0, 1, 2}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		output := make([]int, 0)
+		err := ForEachContext(ctx, input, func(v int) {
+			output = append(output, v)
+			if v == 2 {
+				cancel()
+			}
+		})
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("error is missmatch: %v", err)
+		}
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestMapContext(t *testing.T) {
+	{
+		input := []int{0, 1, 2}
+		expect := []int{0, 2, 4}
+
+		output, err := MapContext(context.Background(), input, func(v int) int { return v * 2 })
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []int{0, 1, 2}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		output, err := MapContext(ctx, input, func(v int) int { return v * 2 })
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("error is missmatch: %v", err)
+		}
+		if output != nil {
+			t.Errorf("result is missmatch: %v", output)
+		}
+	}
+}
+
+func TestReduceContext(t *testing.T) {
+	{
+		input := []int{1, 2, 3, 4}
+		expect := 3
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		output, err := ReduceContext(ctx, input, func(v int, acc int) int {
+			if v == 2 {
+				cancel()
+			}
+			return acc + v
+		}, 0)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("error is missmatch: %v", err)
+		}
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
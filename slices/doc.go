@@ -0,0 +1,6 @@
+// Package slices provides lodash-style helpers for Go slices.
+//
+// The exported API follows semantic versioning: within a major version,
+// exported identifiers are neither removed nor changed in an incompatible
+// way. New helpers may be added in minor versions.
+package slices
@@ -0,0 +1,201 @@
+package slices
+
+import (
+	"errors"
+	"fmt"
+)
+
+// IndexError records the index of the element whose callback failed.
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+// FilterErr is like Filter, but pred may fail. It stops at the first error
+// and returns it wrapped in an *IndexError.
+func FilterErr[T any](ary []T, pred func(T) (bool, error)) ([]T, error) {
+	n := make([]T, 0)
+	for i, a := range ary {
+		ok, err := pred(a)
+		if err != nil {
+			return nil, &IndexError{Index: i, Err: err}
+		}
+		if ok {
+			n = append(n, a)
+		}
+	}
+	return n, nil
+}
+
+// FilterErrAll is like FilterErr, but visits every element. Elements whose
+// predicate failed are left out, and the failures are returned joined.
+func FilterErrAll[T any](ary []T, pred func(T) (bool, error)) ([]T, error) {
+	n := make([]T, 0)
+	errs := make([]error, 0)
+	for i, a := range ary {
+		ok, err := pred(a)
+		if err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
+			continue
+		}
+		if ok {
+			n = append(n, a)
+		}
+	}
+	return n, errors.Join(errs...)
+}
+
+// FindErr is like Find, but pred may fail. It stops at the first error and
+// returns it wrapped in an *IndexError.
+func FindErr[T any](ary []T, pred func(T) (bool, error)) (T, bool, error) {
+	var v T
+	for i, a := range ary {
+		ok, err := pred(a)
+		if err != nil {
+			return v, false, &IndexError{Index: i, Err: err}
+		}
+		if ok {
+			return a, true, nil
+		}
+	}
+	return v, false, nil
+}
+
+// FindErrAll is like FindErr, but skips elements whose predicate failed and
+// keeps searching. It returns the first match along with the failures met
+// before it, joined.
+func FindErrAll[T any](ary []T, pred func(T) (bool, error)) (T, bool, error) {
+	var v T
+	errs := make([]error, 0)
+	for i, a := range ary {
+		ok, err := pred(a)
+		if err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
+			continue
+		}
+		if ok {
+			return a, true, errors.Join(errs...)
+		}
+	}
+	return v, false, errors.Join(errs...)
+}
+
+// ForEachErr is like ForEach, but f may fail. It stops at the first error and
+// returns it wrapped in an *IndexError.
+func ForEachErr[T any](ary []T, f func(T) error) error {
+	for i, a := range ary {
+		if err := f(a); err != nil {
+			return &IndexError{Index: i, Err: err}
+		}
+	}
+	return nil
+}
+
+// ForEachErrAll is like ForEachErr, but visits every element and returns the
+// failures joined.
+func ForEachErrAll[T any](ary []T, f func(T) error) error {
+	errs := make([]error, 0)
+	for i, a := range ary {
+		if err := f(a); err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// GroupByErr is like GroupBy, but f may fail. It stops at the first error and
+// returns it wrapped in an *IndexError.
+func GroupByErr[T any, U comparable](ary []T, f func(T) (U, error)) (map[U][]T, error) {
+	group := map[U][]T{}
+	for i, a := range ary {
+		key, err := f(a)
+		if err != nil {
+			return nil, &IndexError{Index: i, Err: err}
+		}
+		group[key] = append(group[key], a)
+	}
+	return group, nil
+}
+
+// GroupByErrAll is like GroupByErr, but visits every element. Elements whose
+// key could not be generated are left out, and the failures are returned
+// joined.
+func GroupByErrAll[T any, U comparable](ary []T, f func(T) (U, error)) (map[U][]T, error) {
+	group := map[U][]T{}
+	errs := make([]error, 0)
+	for i, a := range ary {
+		key, err := f(a)
+		if err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
+			continue
+		}
+		group[key] = append(group[key], a)
+	}
+	return group, errors.Join(errs...)
+}
+
+// MapErr is like Map, but conv may fail. It stops at the first error and
+// returns it wrapped in an *IndexError.
+func MapErr[T, U any](ary []T, conv func(T) (U, error)) ([]U, error) {
+	n := make([]U, len(ary))
+	for i, a := range ary {
+		u, err := conv(a)
+		if err != nil {
+			return nil, &IndexError{Index: i, Err: err}
+		}
+		n[i] = u
+	}
+	return n, nil
+}
+
+// MapErrAll is like MapErr, but visits every element. Failed elements are
+// left as the zero value of U, and the failures are returned joined.
+func MapErrAll[T, U any](ary []T, conv func(T) (U, error)) ([]U, error) {
+	n := make([]U, len(ary))
+	errs := make([]error, 0)
+	for i, a := range ary {
+		u, err := conv(a)
+		if err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
+			continue
+		}
+		n[i] = u
+	}
+	return n, errors.Join(errs...)
+}
+
+// ReduceErr is like Reduce, but f may fail. It stops at the first error and
+// returns it wrapped in an *IndexError, along with the accumulator so far.
+func ReduceErr[T, U any](ary []T, f func(T, U) (U, error), acc U) (U, error) {
+	for i, a := range ary {
+		next, err := f(a, acc)
+		if err != nil {
+			return acc, &IndexError{Index: i, Err: err}
+		}
+		acc = next
+	}
+	return acc, nil
+}
+
+// ReduceErrAll is like ReduceErr, but visits every element. Failed elements
+// leave the accumulator unchanged, and the failures are returned joined.
+func ReduceErrAll[T, U any](ary []T, f func(T, U) (U, error), acc U) (U, error) {
+	errs := make([]error, 0)
+	for i, a := range ary {
+		next, err := f(a, acc)
+		if err != nil {
+			errs = append(errs, &IndexError{Index: i, Err: err})
+			continue
+		}
+		acc = next
+	}
+	return acc, errors.Join(errs...)
+}
@@ -0,0 +1,252 @@
+package slices
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFilterErr(t *testing.T) {
+	pred := func(v string) (bool, error) {
+		n, err := strconv.Atoi(v)
+		return n%2 == 0, err
+	}
+
+	{
+		input := []string{"0", "1", "2"}
+		expect := []string{"0", "2"}
+
+		output, err := FilterErr(input, pred)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []string{"0", "x", "2", "y"}
+		expect := []string{"0", "2"}
+
+		output, err := FilterErrAll(input, pred)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+
+		var numErr *strconv.NumError
+		if !errors.As(err, &numErr) {
+			t.Errorf("error does not wrap *strconv.NumError: %v", err)
+		}
+		if diff := cmp.Diff(2, len(err.(interface{ Unwrap() []error }).Unwrap())); diff != "" {
+			t.Errorf("error count is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestFindErr(t *testing.T) {
+	pred := func(v string) (bool, error) {
+		n, err := strconv.Atoi(v)
+		return n > 1, err
+	}
+
+	{
+		input := []string{"0", "2", "x"}
+
+		output, exist, err := FindErr(input, pred)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !exist || output != "2" {
+			t.Errorf("result is missmatch: %q, %v", output, exist)
+		}
+	}
+	{
+		input := []string{"0", "x", "2"}
+
+		_, exist, err := FindErr(input, pred)
+		var indexErr *IndexError
+		if !errors.As(err, &indexErr) || indexErr.Index != 1 {
+			t.Errorf("error is missmatch: %v", err)
+		}
+		if exist {
+			t.Errorf("result is missmatch: %v", exist)
+		}
+	}
+	{
+		input := []string{"0", "x", "2", "y"}
+
+		output, exist, err := FindErrAll(input, pred)
+		if !exist || output != "2" {
+			t.Errorf("result is missmatch: %q, %v", output, exist)
+		}
+		var indexErr *IndexError
+		if !errors.As(err, &indexErr) || indexErr.Index != 1 {
+			t.Errorf("error is missmatch: %v", err)
+		}
+		if diff := cmp.Diff(1, len(err.(interface{ Unwrap() []error }).Unwrap())); diff != "" {
+			t.Errorf("error count is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []string{"0", "x"}
+
+		_, exist, err := FindErrAll(input, pred)
+		if exist || err == nil {
+			t.Errorf("result is missmatch: %v, %v", exist, err)
+		}
+	}
+}
+
+func TestForEachErr(t *testing.T) {
+	failure := errors.New("failure")
+
+	{
+		input := []int{0, 1, 2, 3}
+		expect := []int{0, 1}
+
+		output := make([]int, 0)
+		err := ForEachErr(input, func(v int) error {
+			if v == 2 {
+				return failure
+			}
+			output = append(output, v)
+			return nil
+		})
+		if !errors.Is(err, failure) {
+			t.Errorf("error is missmatch: %v", err)
+		}
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []int{0, 1, 2, 3}
+		expect := "index 1: failure\nindex 3: failure"
+
+		err := ForEachErrAll(input, func(v int) error {
+			if v%2 == 1 {
+				return failure
+			}
+			return nil
+		})
+		if diff := cmp.Diff(expect, err.Error()); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestGroupByErr(t *testing.T) {
+	f := func(v string) (bool, error) {
+		n, err := strconv.Atoi(v)
+		return n%2 == 0, err
+	}
+
+	{
+		input := []string{"0", "1", "2"}
+		expect := map[bool][]string{true: {"0", "2"}, false: {"1"}}
+
+		output, err := GroupByErr(input, f)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []string{"0", "x", "2"}
+		expect := map[bool][]string{true: {"0", "2"}}
+
+		output, err := GroupByErrAll(input, f)
+		var indexErr *IndexError
+		if !errors.As(err, &indexErr) || indexErr.Index != 1 {
+			t.Errorf("error is missmatch: %v", err)
+		}
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestMapErr(t *testing.T) {
+	{
+		input := []string{"0", "1", "2"}
+		expect := []int{0, 1, 2}
+
+		output, err := MapErr(input, strconv.Atoi)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []string{"0", "x", "2"}
+
+		output, err := MapErr(input, strconv.Atoi)
+		var indexErr *IndexError
+		if !errors.As(err, &indexErr) || indexErr.Index != 1 {
+			t.Errorf("error is missmatch: %v", err)
+		}
+		if output != nil {
+			t.Errorf("result is missmatch: %v", output)
+		}
+	}
+	{
+		input := []string{"0", "x", "2"}
+		expect := []int{0, 0, 2}
+
+		output, err := MapErrAll(input, strconv.Atoi)
+		if err == nil {
+			t.Errorf("error is missing")
+		}
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestReduceErr(t *testing.T) {
+	f := func(v string, acc int) (int, error) {
+		n, err := strconv.Atoi(v)
+		return acc + n, err
+	}
+
+	{
+		input := []string{"1", "2", "3"}
+
+		output, err := ReduceErr(input, f, 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(6, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []string{"1", "2", "x", "3"}
+
+		output, err := ReduceErr(input, f, 0)
+		var indexErr *IndexError
+		if !errors.As(err, &indexErr) || indexErr.Index != 2 {
+			t.Errorf("error is missmatch: %v", err)
+		}
+		if diff := cmp.Diff(3, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []string{"1", "x", "2", "y", "3"}
+
+		output, err := ReduceErrAll(input, f, 0)
+		if diff := cmp.Diff(6, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff(2, len(err.(interface{ Unwrap() []error }).Unwrap())); diff != "" {
+			t.Errorf("error count is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package slices
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// ParallelFilter is like Filter, but calls pred from up to workers goroutines.
+// The order of the result follows ary. If workers is less than 1,
+// runtime.GOMAXPROCS(0) is used. A panic in pred is re-raised in the caller.
+func ParallelFilter[T any](ary []T, workers int, pred func(T) bool) []T {
+	keep := make([]bool, len(ary))
+	parallel(len(ary), workers, func(i int) {
+		keep[i] = pred(ary[i])
+	})
+
+	n := make([]T, 0)
+	for i, a := range ary {
+		if keep[i] {
+			n = append(n, a)
+		}
+	}
+	return n
+}
+
+// ParallelForEach is like ForEach, but calls f from up to workers goroutines,
+// so the calls are not ordered. If workers is less than 1,
+// runtime.GOMAXPROCS(0) is used. A panic in f is re-raised in the caller.
+func ParallelForEach[T any](ary []T, workers int, f func(T)) {
+	parallel(len(ary), workers, func(i int) {
+		f(ary[i])
+	})
+}
+
+// ParallelMap is like Map, but calls conv from up to workers goroutines.
+// The order of the result follows ary. If workers is less than 1,
+// runtime.GOMAXPROCS(0) is used. A panic in conv is re-raised in the caller.
+func ParallelMap[T, U any](ary []T, workers int, conv func(T) U) []U {
+	n := make([]U, len(ary))
+	parallel(len(ary), workers, func(i int) {
+		n[i] = conv(ary[i])
+	})
+	return n
+}
+
+// ParallelFilterContext is like ParallelFilter, but stops handing out work
+// once ctx is done and returns ctx.Err().
+func ParallelFilterContext[T any](ctx context.Context, ary []T, workers int, pred func(T) bool) ([]T, error) {
+	keep := make([]bool, len(ary))
+	err := parallelContext(ctx, len(ary), workers, func(i int) {
+		keep[i] = pred(ary[i])
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	n := make([]T, 0)
+	for i, a := range ary {
+		if keep[i] {
+			n = append(n, a)
+		}
+	}
+	return n, nil
+}
+
+// ParallelForEachContext is like ParallelForEach, but stops handing out work
+// once ctx is done and returns ctx.Err().
+func ParallelForEachContext[T any](ctx context.Context, ary []T, workers int, f func(T)) error {
+	return parallelContext(ctx, len(ary), workers, func(i int) {
+		f(ary[i])
+	})
+}
+
+// ParallelMapContext is like ParallelMap, but stops handing out work once ctx
+// is done and returns ctx.Err().
+func ParallelMapContext[T, U any](ctx context.Context, ary []T, workers int, conv func(T) U) ([]U, error) {
+	n := make([]U, len(ary))
+	err := parallelContext(ctx, len(ary), workers, func(i int) {
+		n[i] = conv(ary[i])
+	})
+	if err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// parallel calls f for every index in [0, size) from up to workers goroutines.
+func parallel(size, workers int, f func(int)) {
+	parallelContext(context.Background(), size, workers, f)
+}
+
+// parallelContext calls f for every index in [0, size) from up to workers
+// goroutines. Indices are handed out in chunks, and ctx is checked before each
+// chunk. Once f panics, the workers stop picking up new chunks and the first
+// panic value is re-raised after all of them return.
+func parallelContext(ctx context.Context, size, workers int, f func(int)) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > size {
+		workers = size
+	}
+	if workers == 0 {
+		return nil
+	}
+
+	chunk := size / (workers * 4)
+	if chunk < 1 {
+		chunk = 1
+	}
+
+	var (
+		next      atomic.Int64
+		failed    atomic.Bool
+		cancelled atomic.Bool
+		once      sync.Once
+		failure   any
+		wg        sync.WaitGroup
+	)
+
+	worker := func() {
+		defer wg.Done()
+		defer func() {
+			if p := recover(); p != nil {
+				once.Do(func() { failure = p })
+				failed.Store(true)
+			}
+		}()
+
+		for !failed.Load() {
+			if ctx.Err() != nil {
+				cancelled.Store(true)
+				return
+			}
+			start := int(next.Add(int64(chunk))) - chunk
+			if start >= size {
+				return
+			}
+			end := min(start+chunk, size)
+			for i := start; i < end; i++ {
+				f(i)
+			}
+		}
+	}
+
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go worker()
+	}
+	wg.Wait()
+
+	if failed.Load() {
+		panic(failure)
+	}
+	if cancelled.Load() {
+		return ctx.Err()
+	}
+	return nil
+}
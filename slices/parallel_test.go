@@ -0,0 +1,151 @@
+package slices
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParallelFilter(t *testing.T) {
+	{
+		input := make([]int, 1000)
+		for i := range input {
+			input[i] = i
+		}
+		pred := func(v int) bool { return v%3 == 0 }
+		expect := Filter(input, pred)
+
+		output := ParallelFilter(input, 4, pred)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []int{}
+		expect := []int{}
+
+		output := ParallelFilter(input, 4, func(v int) bool { return true })
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestParallelForEach(t *testing.T) {
+	{
+		input := []int{0, 1, 2, 3, 4, 5, 6, 7}
+		expect := []int{0, 1, 2, 3, 4, 5, 6, 7}
+
+		var mu sync.Mutex
+		output := make([]int, 0)
+		ParallelForEach(input, 3, func(v int) {
+			mu.Lock()
+			defer mu.Unlock()
+			output = append(output, v)
+		})
+		sort.Ints(output)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestParallelMap(t *testing.T) {
+	{
+		input := make([]int, 1000)
+		for i := range input {
+			input[i] = i
+		}
+		conv := func(v int) int { return v * 2 }
+		expect := Map(input, conv)
+
+		output := ParallelMap(input, 8, conv)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []int{0, 1, 2}
+		expect := []int{0, 2, 4}
+
+		output := ParallelMap(input, 0, func(v int) int { return v * 2 })
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []int{0, 1, 2, 3, 4, 5, 6, 7}
+		expect := "boom"
+
+		output := func() (p any) {
+			defer func() { p = recover() }()
+			ParallelMap(input, 4, func(v int) int {
+				if v == 5 {
+					panic("boom")
+				}
+				return v
+			})
+			return nil
+		}()
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestParallelMapContext(t *testing.T) {
+	{
+		input := []int{0, 1, 2, 3}
+		expect := []int{0, 2, 4, 6}
+
+		output, err := ParallelMapContext(context.Background(), input, 2, func(v int) int { return v * 2 })
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := make([]int, 1000)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var calls atomic.Int64
+		output, err := ParallelMapContext(ctx, input, 2, func(v int) int {
+			if calls.Add(1) == 10 {
+				cancel()
+			}
+			return v
+		})
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("error is missmatch: %v", err)
+		}
+		if output != nil {
+			t.Errorf("result is missmatch: %v", output)
+		}
+		if calls.Load() == int64(len(input)) {
+			t.Errorf("all elements were processed after cancellation")
+		}
+	}
+}
+
+func TestParallelFilterContext(t *testing.T) {
+	{
+		input := []int{0, 1, 2, 3}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := ParallelFilterContext(ctx, input, 2, func(v int) bool { return true })
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("error is missmatch: %v", err)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package slices
+
+// Seq wraps a slice so that helpers can be chained in reading order:
+//
+//	out := Chain(ary).Filter(isEven).Drop(1).Take(10).Value()
+//
+// Methods never modify the wrapped slice in place. Go methods cannot declare
+// their own type parameters, so steps that change the element type or need
+// a stricter constraint are provided as functions with a Seq suffix
+// (MapSeq, GroupBySeq, ReduceSeq, UniqSeq, ChunkSeq, ...).
+type Seq[T any] struct {
+	ary []T
+}
+
+// Chain wraps ary in a Seq.
+func Chain[T any](ary []T) Seq[T] {
+	return Seq[T]{ary: ary}
+}
+
+// Value returns the wrapped slice.
+func (s Seq[T]) Value() []T {
+	return s.ary
+}
+
+// Len returns the number of elements in the sequence.
+func (s Seq[T]) Len() int {
+	return len(s.ary)
+}
+
+// Concat appends the elements of ary to the sequence. See Concat.
+func (s Seq[T]) Concat(ary []T) Seq[T] {
+	return Chain(Concat(s.ary, ary))
+}
+
+// Drop drops the first size elements. See Drop.
+func (s Seq[T]) Drop(size int) Seq[T] {
+	return Chain(Drop(s.ary, size))
+}
+
+// DropRight drops the last size elements. See DropRight.
+func (s Seq[T]) DropRight(size int) Seq[T] {
+	return Chain(DropRight(s.ary, size))
+}
+
+// DropWhile drops leading elements while pred returns true. See DropWhile.
+func (s Seq[T]) DropWhile(pred func(T) bool) Seq[T] {
+	return Chain(DropWhile(s.ary, pred))
+}
+
+// Every reports whether pred returns true for all elements. See Every.
+func (s Seq[T]) Every(pred func(T) bool) bool {
+	return Every(s.ary, pred)
+}
+
+// Filter keeps the elements for which pred returns true. See Filter.
+func (s Seq[T]) Filter(pred func(T) bool) Seq[T] {
+	return Chain(Filter(s.ary, pred))
+}
+
+// Find returns the first element for which pred returns true. See Find.
+func (s Seq[T]) Find(pred func(T) bool) (T, bool) {
+	return Find(s.ary, pred)
+}
+
+// ForEach calls f for each element. See ForEach.
+func (s Seq[T]) ForEach(f func(T)) {
+	ForEach(s.ary, f)
+}
+
+// Reject drops the elements for which pred returns true. See Reject.
+func (s Seq[T]) Reject(pred func(T) bool) Seq[T] {
+	return Chain(Reject(s.ary, pred))
+}
+
+// Reverse returns the elements in reverse order. Unlike Reverse, it leaves
+// the wrapped slice untouched.
+func (s Seq[T]) Reverse() Seq[T] {
+	out := make([]T, len(s.ary))
+	copy(out, s.ary)
+	Reverse(out)
+	return Chain(out)
+}
+
+// Some reports whether pred returns true for any element. See Some.
+func (s Seq[T]) Some(pred func(T) bool) bool {
+	return Some(s.ary, pred)
+}
+
+// Take keeps the first n elements. See Take.
+func (s Seq[T]) Take(n int) Seq[T] {
+	return Chain(Take(s.ary, n))
+}
+
+// TakeRight keeps the last n elements. See TakeRight.
+func (s Seq[T]) TakeRight(n int) Seq[T] {
+	return Chain(TakeRight(s.ary, n))
+}
+
+// TakeWhile keeps leading elements while pred returns true. See TakeWhile.
+func (s Seq[T]) TakeWhile(pred func(T) bool) Seq[T] {
+	return Chain(TakeWhile(s.ary, pred))
+}
+
+// UniqWith removes elements that pred considers duplicates. See UniqWith.
+func (s Seq[T]) UniqWith(pred func(T, T) bool) Seq[T] {
+	return Chain(UniqWith(s.ary, pred))
+}
+
+// ChunkSeq splits s into groups of length size. See Chunk.
+func ChunkSeq[T any](s Seq[T], size int) Seq[[]T] {
+	return Chain(Chunk(s.ary, size))
+}
+
+// FlattenSeq concatenates the slices of s into a single sequence. See Flatten.
+func FlattenSeq[T any](s Seq[[]T]) Seq[T] {
+	return Chain(Flatten(s.ary))
+}
+
+// GroupBySeq groups the elements of s by the key generated by f. See GroupBy.
+func GroupBySeq[T any, U comparable](s Seq[T], f func(T) U) map[U]Seq[T] {
+	out := map[U]Seq[T]{}
+	for k, v := range GroupBy(s.ary, f) {
+		out[k] = Chain(v)
+	}
+	return out
+}
+
+// MapSeq converts each element of s with conv. See Map.
+func MapSeq[T, U any](s Seq[T], conv func(T) U) Seq[U] {
+	return Chain(Map(s.ary, conv))
+}
+
+// ReduceSeq folds s from left to right into acc using f. See Reduce.
+func ReduceSeq[T, U any](s Seq[T], f func(T, U) U, acc U) U {
+	return Reduce(s.ary, f, acc)
+}
+
+// UniqSeq removes duplicate elements from s. See Uniq.
+func UniqSeq[T comparable](s Seq[T]) Seq[T] {
+	return Chain(Uniq(s.ary))
+}
+
+// UniqBySeq removes elements of s with duplicate keys. See UniqBy.
+func UniqBySeq[T any, U comparable](s Seq[T], conv func(T) U) Seq[T] {
+	return Chain(UniqBy(s.ary, conv))
+}
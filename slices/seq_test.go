@@ -0,0 +1,104 @@
+package slices
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSeq(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	{
+		input := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+		expect := []int{6, 4, 2}
+
+		output := Chain(input).Filter(isEven).Drop(1).Take(3).Reverse().Value()
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []int{0, 1, 2, 3, 4, 5}
+		expect := []int{1, 3, 5}
+
+		output := Chain(input).Reject(isEven).Value()
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []int{0, 1, 2}
+		expect := []int{0, 1, 2}
+
+		Chain(input).Reverse()
+		if diff := cmp.Diff(expect, input); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestMapSeq(t *testing.T) {
+	{
+		input := []int{3, 1, 3, 2, 1}
+		expect := []string{"3", "1", "2"}
+
+		output := MapSeq(UniqSeq(Chain(input)), func(v int) string {
+			return fmt.Sprintf("%d", v)
+		}).Value()
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestChunkSeq(t *testing.T) {
+	{
+		input := []int{0, 1, 2, 3, 4}
+		expect := [][]int{{0, 1}, {2, 3}, {4}}
+
+		output := ChunkSeq(Chain(input), 2).Value()
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []int{0, 1, 2, 3, 4}
+		expect := []int{0, 1, 2, 3, 4}
+
+		output := FlattenSeq(ChunkSeq(Chain(input), 2)).Value()
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestGroupBySeq(t *testing.T) {
+	{
+		input := []int{0, 1, 2, 3, 4}
+		expect := map[bool][]int{true: {0, 2, 4}, false: {1, 3}}
+		f := func(v int) bool { return v%2 == 0 }
+
+		output := map[bool][]int{}
+		for k, v := range GroupBySeq(Chain(input), f) {
+			output[k] = v.Value()
+		}
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestReduceSeq(t *testing.T) {
+	{
+		input := []int{0, 1, 2, 3, 4}
+		expect := 6
+
+		output := ReduceSeq(Chain(input).Filter(func(v int) bool { return v%2 == 0 }),
+			func(v int, acc int) int { return acc + v }, 0)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
@@ -1,12 +1,15 @@
 package slices
 
 import (
+	"cmp"
 	"strings"
 
 	"golang.org/x/exp/constraints"
 )
 
-func chunk[T any](ary []T, size int) [][]T {
+// Chunk splits ary into groups of length size. The final group holds the
+// remaining elements. If size is less than 1, ary is returned as a single group.
+func Chunk[T any](ary []T, size int) [][]T {
 	if len(ary) == 0 || size < 1 || len(ary) <= size {
 		return [][]T{ary}
 	}
@@ -28,50 +31,54 @@ func chunk[T any](ary []T, size int) [][]T {
 	return out
 }
 
-func concat[T any](a1 []T, a2 []T) []T {
+// Concat returns a new slice holding the elements of a1 followed by those of a2.
+func Concat[T any](a1 []T, a2 []T) []T {
 	n := make([]T, len(a1)+len(a2))
 	copy(n[0:], a1)
 	copy(n[len(a1):], a2)
 	return n
 }
 
-func difference[T comparable](a1 []T, a2 []T) []T {
+// Difference returns the elements of a1 that are not present in a2.
+func Difference[T comparable](a1 []T, a2 []T) []T {
+	set := toSet(a2)
+
 	n := make([]T, 0)
 	for _, v := range a1 {
-		if !includes(a2, v) {
+		if _, ok := set[v]; !ok {
 			n = append(n, v)
 		}
 	}
 	return n
 }
 
-func differenceBy[T any, U comparable](a1 []T, a2 []T, f func(T) U) []T {
-	b2 := make([]U, len(a2))
-	for i, v := range a2 {
-		b2[i] = f(v)
-	}
+// DifferenceBy is like Difference, but compares the keys generated by f.
+func DifferenceBy[T any, U comparable](a1 []T, a2 []T, f func(T) U) []T {
+	set := toSetBy(a2, f)
 
 	n := make([]T, 0)
 	for _, v := range a1 {
-		if !includes(b2, f(v)) {
+		if _, ok := set[f(v)]; !ok {
 			n = append(n, v)
 		}
 	}
 	return n
 }
 
-func differenceWith[T any](a1 []T, a2 []T, pred func(T, T) bool) []T {
+// DifferenceWith is like Difference, but compares elements with pred.
+func DifferenceWith[T any](a1 []T, a2 []T, pred func(T, T) bool) []T {
 	n := make([]T, 0)
 	for _, v1 := range a1 {
 		f := func(v2 T) bool { return pred(v1, v2) }
-		if !some(a2, f) {
+		if !Some(a2, f) {
 			n = append(n, v1)
 		}
 	}
 	return n
 }
 
-func drop[T any](ary []T, size int) []T {
+// Drop returns ary without its first size elements.
+func Drop[T any](ary []T, size int) []T {
 	if len(ary) == 0 || size < 1 {
 		return ary
 	}
@@ -84,7 +91,8 @@ func drop[T any](ary []T, size int) []T {
 	return out
 }
 
-func dropRight[T any](ary []T, size int) []T {
+// DropRight returns ary without its last size elements.
+func DropRight[T any](ary []T, size int) []T {
 	if len(ary) == 0 || size < 1 {
 		return ary
 	}
@@ -97,7 +105,8 @@ func dropRight[T any](ary []T, size int) []T {
 	return out
 }
 
-func dropRightWhile[T any](ary []T, pred func(T) bool) []T {
+// DropRightWhile drops elements from the end of ary while pred returns true.
+func DropRightWhile[T any](ary []T, pred func(T) bool) []T {
 	index := 0
 	for i := len(ary); i > 0; i-- {
 		if !pred(ary[i-1]) {
@@ -115,7 +124,8 @@ func dropRightWhile[T any](ary []T, pred func(T) bool) []T {
 	return out
 }
 
-func dropWhile[T any](ary []T, pred func(T) bool) []T {
+// DropWhile drops elements from the beginning of ary while pred returns true.
+func DropWhile[T any](ary []T, pred func(T) bool) []T {
 	index := len(ary)
 	for i, v := range ary {
 		if !pred(v) {
@@ -133,13 +143,16 @@ func dropWhile[T any](ary []T, pred func(T) bool) []T {
 	return out
 }
 
-func fill[T any](ary []T, v T) {
+// Fill overwrites every element of ary with v.
+func Fill[T any](ary []T, v T) {
 	for i, _ := range ary {
 		ary[i] = v
 	}
 }
 
-func findIndex[T any](ary []T, pred func(T) bool) int {
+// FindIndex returns the index of the first element for which pred returns true,
+// or -1 if there is none.
+func FindIndex[T any](ary []T, pred func(T) bool) int {
 	for i, a := range ary {
 		if pred(a) {
 			return i
@@ -148,7 +161,8 @@ func findIndex[T any](ary []T, pred func(T) bool) int {
 	return -1
 }
 
-func findLastIndex[T any](ary []T, pred func(T) bool) int {
+// FindLastIndex is like FindIndex, but iterates over ary from right to left.
+func FindLastIndex[T any](ary []T, pred func(T) bool) int {
 	for i := len(ary); i > 0; i-- {
 		if pred(ary[i-1]) {
 			return i - 1
@@ -157,7 +171,19 @@ func findLastIndex[T any](ary []T, pred func(T) bool) int {
 	return -1
 }
 
-func flatten[T any](ary [][]T) []T {
+// FirstOk returns the first element of ary. The boolean result reports whether
+// ary is non-empty. It is the non-panicking counterpart of Head.
+func FirstOk[T any](ary []T) (T, bool) {
+	return NthOk(ary, 0)
+}
+
+// FirstOr returns the first element of ary, or fallback if ary is empty.
+func FirstOr[T any](ary []T, fallback T) T {
+	return NthOr(ary, 0, fallback)
+}
+
+// Flatten concatenates the slices of ary into a single slice.
+func Flatten[T any](ary [][]T) []T {
 	size := 0
 	for _, v := range ary {
 		size += len(v)
@@ -173,14 +199,16 @@ func flatten[T any](ary [][]T) []T {
 	return out
 }
 
-func head[T any](ary []T) T {
+// Head returns the first element of ary. It panics if ary is empty.
+func Head[T any](ary []T) T {
 	if len(ary) == 0 {
 		panic("slice is empty (slice head is out of range)")
 	}
 	return ary[0]
 }
 
-func indexOf[T comparable](ary []T, v T) int {
+// IndexOf returns the index of the first occurrence of v in ary, or -1.
+func IndexOf[T comparable](ary []T, v T) int {
 	for i, a := range ary {
 		if a == v {
 			return i
@@ -189,61 +217,68 @@ func indexOf[T comparable](ary []T, v T) int {
 	return -1
 }
 
-func initial[T any](ary []T) []T {
+// Initial returns all but the last element of ary, or an empty slice if ary
+// is empty.
+func Initial[T any](ary []T) []T {
 	if len(ary) == 0 {
-		panic("slice is empty (slice initial is out of range)")
+		return []T{}
 	}
 	return ary[:len(ary)-1]
 }
 
-func intersection[T comparable](a1 []T, a2 []T) []T {
+// Intersection returns the elements of a1 that are also present in a2.
+func Intersection[T comparable](a1 []T, a2 []T) []T {
+	set := toSet(a2)
+
 	n := make([]T, 0)
 	for _, v := range a1 {
-		if includes(a2, v) {
+		if _, ok := set[v]; ok {
 			n = append(n, v)
 		}
 	}
 	return n
 }
 
-func intersectionBy[T, U comparable](a1 []T, a2 []T, f func(T) U) []T {
-	b2 := make([]U, len(a2))
-	for i, v := range a2 {
-		b2[i] = f(v)
-	}
+// IntersectionBy is like Intersection, but compares the keys generated by f.
+func IntersectionBy[T, U comparable](a1 []T, a2 []T, f func(T) U) []T {
+	set := toSetBy(a2, f)
 
 	n := make([]T, 0)
 	for _, v := range a1 {
-		if includes(b2, f(v)) {
+		if _, ok := set[f(v)]; ok {
 			n = append(n, v)
 		}
 	}
 	return n
 }
 
-func intersectionWith[T comparable](a1 []T, a2 []T, pred func(T, T) bool) []T {
+// IntersectionWith is like Intersection, but compares elements with pred.
+func IntersectionWith[T comparable](a1 []T, a2 []T, pred func(T, T) bool) []T {
 	n := make([]T, 0)
 	for _, v1 := range a1 {
 		f := func(v2 T) bool { return pred(v1, v2) }
-		if some(a2, f) {
+		if Some(a2, f) {
 			n = append(n, v1)
 		}
 	}
 	return n
 }
 
-func join(ary []string, separator string) string {
+// Join concatenates the elements of ary, placing separator between them.
+func Join(ary []string, separator string) string {
 	return strings.Join(ary, separator)
 }
 
-func last[T any](ary []T) T {
+// Last returns the last element of ary. It panics if ary is empty.
+func Last[T any](ary []T) T {
 	if len(ary) == 0 {
 		panic("slice is empty (slice last is out of range)")
 	}
 	return ary[len(ary)-1]
 }
 
-func lastIndexOf[T comparable](ary []T, v T) int {
+// LastIndexOf returns the index of the last occurrence of v in ary, or -1.
+func LastIndexOf[T comparable](ary []T, v T) int {
 	for i := len(ary); i > 0; i-- {
 		if ary[i-1] == v {
 			return i - 1
@@ -252,7 +287,20 @@ func lastIndexOf[T comparable](ary []T, v T) int {
 	return -1
 }
 
-func nth[T any](ary []T, index int) T {
+// LastOk returns the last element of ary. The boolean result reports whether
+// ary is non-empty. It is the non-panicking counterpart of Last.
+func LastOk[T any](ary []T) (T, bool) {
+	return NthOk(ary, -1)
+}
+
+// LastOr returns the last element of ary, or fallback if ary is empty.
+func LastOr[T any](ary []T, fallback T) T {
+	return NthOr(ary, -1, fallback)
+}
+
+// Nth returns the element at index of ary. A negative index counts from the end.
+// It panics if index is out of range.
+func Nth[T any](ary []T, index int) T {
 	if index < 0 {
 		index = len(ary) + index
 	}
@@ -263,7 +311,32 @@ func nth[T any](ary []T, index int) T {
 	return ary[index]
 }
 
-func reverse[T any](ary []T) {
+// NthOk returns the element at index of ary. A negative index counts from the
+// end. The boolean result reports whether index is in range. It is the
+// non-panicking counterpart of Nth.
+func NthOk[T any](ary []T, index int) (T, bool) {
+	if index < 0 {
+		index = len(ary) + index
+	}
+	if index < 0 || len(ary) <= index {
+		var v T
+		return v, false
+	}
+
+	return ary[index], true
+}
+
+// NthOr returns the element at index of ary, or fallback if index is out of
+// range. A negative index counts from the end.
+func NthOr[T any](ary []T, index int, fallback T) T {
+	if v, ok := NthOk(ary, index); ok {
+		return v
+	}
+	return fallback
+}
+
+// Reverse reverses the elements of ary in place.
+func Reverse[T any](ary []T) {
 	limit := len(ary) / 2
 	for i := 0; i < limit; i++ {
 		j := len(ary) - i - 1
@@ -271,7 +344,8 @@ func reverse[T any](ary []T) {
 	}
 }
 
-func slice[T any](ary []T, ranges ...int) []T {
+// Slice returns ary[start:end], where ranges optionally holds start and end.
+func Slice[T any](ary []T, ranges ...int) []T {
 	start := 0
 	if len(ranges) >= 1 {
 		start = ranges[0]
@@ -285,66 +359,57 @@ func slice[T any](ary []T, ranges ...int) []T {
 	return ary[start:end]
 }
 
-func sortedIndex[T constraints.Ordered](ary []T, value T) int {
+// SortedIndex returns the lowest index at which value should be inserted into
+// the sorted slice ary to keep it sorted.
+func SortedIndex[T constraints.Ordered](ary []T, value T) int {
 	identity := func(v T) T { return v }
-	return sortedIndexBy(ary, value, identity)
+	return SortedIndexBy(ary, value, identity)
 }
 
-func sortedIndexBy[T any, U constraints.Ordered](ary []T, value T, conv func(T) U) int {
-	if len(ary) == 0 {
-		return 0
-	}
-
+// SortedIndexBy is like SortedIndex, but compares the keys generated by conv.
+func SortedIndexBy[T any, U constraints.Ordered](ary []T, value T, conv func(T) U) int {
 	u := conv(value)
-	if len(ary) == 1 {
-		if conv(ary[0]) >= u {
-			return 0
+	low, high := 0, len(ary)
+	for low < high {
+		middle := int(uint(low+high) >> 1)
+		if cmp.Less(conv(ary[middle]), u) {
+			low = middle + 1
 		} else {
-			return 1
+			high = middle
 		}
 	}
-
-	middle := len(ary) / 2
-	if conv(ary[middle]) >= u {
-		return sortedIndexBy(ary[:middle], value, conv)
-	} else {
-		return sortedIndexBy(ary[middle:], value, conv) + middle
-	}
+	return low
 }
 
-func sortedLastIndex[T constraints.Ordered](ary []T, value T) int {
+// SortedLastIndex is like SortedIndex, but returns the highest index.
+func SortedLastIndex[T constraints.Ordered](ary []T, value T) int {
 	identity := func(v T) T { return v }
-	return sortedLastIndexBy(ary, value, identity)
+	return SortedLastIndexBy(ary, value, identity)
 }
 
-func sortedLastIndexBy[T any, U constraints.Ordered](ary []T, value T, conv func(T) U) int {
-	if len(ary) == 0 {
-		return 0
-	}
-
+// SortedLastIndexBy is like SortedLastIndex, but compares the keys generated by conv.
+func SortedLastIndexBy[T any, U constraints.Ordered](ary []T, value T, conv func(T) U) int {
 	u := conv(value)
-	if len(ary) == 1 {
-		if conv(ary[0]) > u {
-			return 0
+	low, high := 0, len(ary)
+	for low < high {
+		middle := int(uint(low+high) >> 1)
+		if !cmp.Less(u, conv(ary[middle])) {
+			low = middle + 1
 		} else {
-			return 1
+			high = middle
 		}
 	}
-
-	middle := len(ary) / 2
-	if conv(ary[middle]) > u {
-		return sortedLastIndexBy(ary[:middle], value, conv)
-	} else {
-		return sortedLastIndexBy(ary[middle:], value, conv) + middle
-	}
+	return low
 }
 
-func sortedUniq[T comparable](ary []T) []T {
+// SortedUniq is like Uniq, but optimized for sorted slices.
+func SortedUniq[T comparable](ary []T) []T {
 	identity := func(v T) T { return v }
-	return sortedUniqBy(ary, identity)
+	return SortedUniqBy(ary, identity)
 }
 
-func sortedUniqBy[T any, U comparable](ary []T, conv func(T) U) []T {
+// SortedUniqBy is like UniqBy, but optimized for sorted slices.
+func SortedUniqBy[T any, U comparable](ary []T, conv func(T) U) []T {
 	if len(ary) == 0 {
 		return ary
 	}
@@ -363,25 +428,33 @@ func sortedUniqBy[T any, U comparable](ary []T, conv func(T) U) []T {
 	return out
 }
 
-func tail[T any](ary []T) []T {
+// Tail returns all but the first element of ary, or an empty slice if ary is
+// empty.
+func Tail[T any](ary []T) []T {
+	if len(ary) == 0 {
+		return []T{}
+	}
 	return ary[1:]
 }
 
-func take[T any](ary []T, n int) []T {
+// Take returns the first n elements of ary.
+func Take[T any](ary []T, n int) []T {
 	if len(ary) <= n {
 		return ary
 	}
 	return ary[:n]
 }
 
-func takeRight[T any](ary []T, n int) []T {
+// TakeRight returns the last n elements of ary.
+func TakeRight[T any](ary []T, n int) []T {
 	if len(ary) <= n {
 		return ary
 	}
 	return ary[len(ary)-n:]
 }
 
-func takeRightWhile[T any](ary []T, pred func(T) bool) []T {
+// TakeRightWhile takes elements from the end of ary while pred returns true.
+func TakeRightWhile[T any](ary []T, pred func(T) bool) []T {
 	for i := len(ary); i > 0; i-- {
 		if !pred(ary[i-1]) {
 			return ary[i:]
@@ -390,7 +463,8 @@ func takeRightWhile[T any](ary []T, pred func(T) bool) []T {
 	return ary
 }
 
-func takeWhile[T any](ary []T, pred func(T) bool) []T {
+// TakeWhile takes elements from the beginning of ary while pred returns true.
+func TakeWhile[T any](ary []T, pred func(T) bool) []T {
 	for i, v := range ary {
 		if !pred(v) {
 			return ary[:i]
@@ -399,120 +473,155 @@ func takeWhile[T any](ary []T, pred func(T) bool) []T {
 	return ary
 }
 
-func union[T comparable](a1 []T, a2 []T) []T {
+// Union returns a1 followed by the elements of a2 that are not yet present.
+func Union[T comparable](a1 []T, a2 []T) []T {
 	n := make([]T, len(a1))
 	copy(n, a1)
 
+	set := toSet(a1)
 	for _, v := range a2 {
-		if !includes(n, v) {
+		if _, ok := set[v]; !ok {
 			n = append(n, v)
+			set[v] = struct{}{}
 		}
 	}
 	return n
 }
 
-func unionBy[T any, U comparable](a1 []T, a2 []T, f func(T) U) []T {
-	b1 := make([]U, len(a1))
-	for i, v := range a1 {
-		b1[i] = f(v)
-	}
+// UnionBy is like Union, but compares the keys generated by f.
+func UnionBy[T any, U comparable](a1 []T, a2 []T, f func(T) U) []T {
+	set := toSetBy(a1, f)
 
 	n := make([]T, len(a1))
 	copy(n, a1)
 	for _, v := range a2 {
-		if !includes(b1, f(v)) {
+		u := f(v)
+		if _, ok := set[u]; !ok {
 			n = append(n, v)
+			set[u] = struct{}{}
 		}
 	}
 	return n
 }
 
-func unionWith[T any](a1 []T, a2 []T, pred func(T, T) bool) []T {
+// UnionWith is like Union, but compares elements with pred.
+func UnionWith[T any](a1 []T, a2 []T, pred func(T, T) bool) []T {
 	n := make([]T, len(a1))
 	copy(n, a1)
 
 	for _, v := range a2 {
 		f := func(v2 T) bool { return pred(v, v2) }
-		if !some(n, f) {
+		if !Some(n, f) {
 			n = append(n, v)
 		}
 	}
 	return n
 }
 
-func uniq[T comparable](ary []T) []T {
+// Uniq returns ary without duplicates, keeping the first occurrence of each element.
+func Uniq[T comparable](ary []T) []T {
+	identity := func(v T) T { return v }
+	return UniqBy(ary, identity)
+}
+
+// UniqBy is like Uniq, but compares the keys generated by conv.
+func UniqBy[T any, U comparable](ary []T, conv func(T) U) []T {
 	out := make([]T, 0)
+	memo := make(map[U]struct{})
+
 	for _, v := range ary {
-		if !includes(out, v) {
+		u := conv(v)
+		if _, ok := memo[u]; !ok {
 			out = append(out, v)
+			memo[u] = struct{}{}
 		}
 	}
 	return out
 }
 
-func uniqBy[T any, U comparable](ary []T, conv func(T) U) []T {
+// UniqLast is like Uniq, but keeps the last occurrence of each element.
+// The kept elements stay in their original relative order.
+func UniqLast[T comparable](ary []T) []T {
+	identity := func(v T) T { return v }
+	return UniqByLast(ary, identity)
+}
+
+// UniqByLast is like UniqBy, but keeps the last occurrence of each key.
+func UniqByLast[T any, U comparable](ary []T, conv func(T) U) []T {
 	out := make([]T, 0)
-	memo := make([]U, 0)
+	memo := make(map[U]struct{})
 
-	for _, v := range ary {
+	for i := len(ary); i > 0; i-- {
+		v := ary[i-1]
 		u := conv(v)
-		if !includes(memo, u) {
+		if _, ok := memo[u]; !ok {
 			out = append(out, v)
-			memo = append(memo, u)
+			memo[u] = struct{}{}
 		}
 	}
+
+	Reverse(out)
 	return out
 }
 
-func uniqWith[T any](ary []T, pred func(T, T) bool) []T {
+// UniqWith is like Uniq, but compares elements with pred.
+func UniqWith[T any](ary []T, pred func(T, T) bool) []T {
 	out := make([]T, 0)
 
 	for _, v := range ary {
 		f := func(v2 T) bool { return pred(v, v2) }
-		if !some(out, f) {
+		if !Some(out, f) {
 			out = append(out, v)
 		}
 	}
 	return out
 }
 
-func unzip[T any](as [][]T) [][]T {
-	return zip(as...)
+// Unzip is the inverse of Zip; it regroups the elements of as by index.
+func Unzip[T any](as [][]T) [][]T {
+	return Zip(as...)
 }
 
-func unzipWith[T, U any](f func(...T) U, as [][]T) []U {
-	return zipWith(f, as...)
+// UnzipWith is like Unzip, but combines each regrouped element with f.
+func UnzipWith[T, U any](f func(...T) U, as [][]T) []U {
+	return ZipWith(f, as...)
 }
 
-func without[T comparable](ary []T, values ...T) []T {
+// Without returns ary without any of the given values.
+func Without[T comparable](ary []T, values ...T) []T {
 	out := make([]T, 0)
 	for _, v := range ary {
-		if !includes(values, v) {
+		if !Includes(values, v) {
 			out = append(out, v)
 		}
 	}
 	return out
 }
 
-func xor[T comparable](a1, a2 []T) []T {
-	out1 := difference(a1, a2)
-	out2 := difference(a2, a1)
-	return concat(out1, out2)
+// Xor returns the symmetric difference of a1 and a2.
+func Xor[T comparable](a1, a2 []T) []T {
+	out1 := Difference(a1, a2)
+	out2 := Difference(a2, a1)
+	return Concat(out1, out2)
 }
 
-func xorBy[T any, U comparable](a1, a2 []T, conv func(T) U) []T {
-	out1 := differenceBy(a1, a2, conv)
-	out2 := differenceBy(a2, a1, conv)
-	return concat(out1, out2)
+// XorBy is like Xor, but compares the keys generated by conv.
+func XorBy[T any, U comparable](a1, a2 []T, conv func(T) U) []T {
+	out1 := DifferenceBy(a1, a2, conv)
+	out2 := DifferenceBy(a2, a1, conv)
+	return Concat(out1, out2)
 }
 
-func xorWith[T any](a1, a2 []T, pred func(T, T) bool) []T {
-	out1 := differenceWith(a1, a2, pred)
-	out2 := differenceWith(a2, a1, pred)
-	return concat(out1, out2)
+// XorWith is like Xor, but compares elements with pred.
+func XorWith[T any](a1, a2 []T, pred func(T, T) bool) []T {
+	out1 := DifferenceWith(a1, a2, pred)
+	out2 := DifferenceWith(a2, a1, pred)
+	return Concat(out1, out2)
 }
 
-func zip[T any](as ...[]T) [][]T {
+// Zip groups the elements of as by index. The result is as long as the
+// shortest input.
+func Zip[T any](as ...[]T) [][]T {
 	if len(as) == 0 {
 		return [][]T{}
 	}
@@ -530,11 +639,28 @@ func zip[T any](as ...[]T) [][]T {
 	}
 }
 
-func zipWith[T, U any](f func(...T) U, as ...[]T) []U {
-	tmp := zip(as...)
+// ZipWith is like Zip, but combines each group with f.
+func ZipWith[T, U any](f func(...T) U, as ...[]T) []U {
+	tmp := Zip(as...)
 	out := make([]U, len(tmp))
 	for i, args := range tmp {
 		out[i] = f(args...)
 	}
 	return out
 }
+
+func toSet[T comparable](ary []T) map[T]struct{} {
+	set := make(map[T]struct{}, len(ary))
+	for _, v := range ary {
+		set[v] = struct{}{}
+	}
+	return set
+}
+
+func toSetBy[T any, U comparable](ary []T, conv func(T) U) map[U]struct{} {
+	set := make(map[U]struct{}, len(ary))
+	for _, v := range ary {
+		set[conv(v)] = struct{}{}
+	}
+	return set
+}
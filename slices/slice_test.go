@@ -1,6 +1,7 @@
 package slices
 
 import (
+	"fmt"
 	"math"
 	"testing"
 
@@ -12,7 +13,7 @@ func TestChunk(t *testing.T) {
 		input := []int{0, 1, 2, 3, 4}
 		expect := [][]int{{0, 1}, {2, 3}, {4}}
 
-		output := chunk(input, 2)
+		output := Chunk(input, 2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -21,7 +22,7 @@ func TestChunk(t *testing.T) {
 		input := []int{0, 1, 2, 3, 4}
 		expect := [][]int{{0, 1, 2, 3, 4}}
 
-		output := chunk(input, 10)
+		output := Chunk(input, 10)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -30,7 +31,7 @@ func TestChunk(t *testing.T) {
 		input := []int{}
 		expect := [][]int{{}}
 
-		output := chunk(input, 10)
+		output := Chunk(input, 10)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -39,7 +40,7 @@ func TestChunk(t *testing.T) {
 		input := []int{0, 1, 2, 3, 4}
 		expect := [][]int{{0, 1, 2, 3, 4}}
 
-		output := chunk(input, -1)
+		output := Chunk(input, -1)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -52,7 +53,7 @@ func TestConcat(t *testing.T) {
 		input2 := []int{2, 3, 4}
 		expect := []int{0, 1, 2, 3, 4}
 
-		output := concat(input1, input2)
+		output := Concat(input1, input2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -62,7 +63,7 @@ func TestConcat(t *testing.T) {
 		input2 := []int{2, 3, 4}
 		expect := []int{2, 3, 4}
 
-		output := concat(input1, input2)
+		output := Concat(input1, input2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -72,7 +73,7 @@ func TestConcat(t *testing.T) {
 		input2 := []int{}
 		expect := []int{}
 
-		output := concat(input1, input2)
+		output := Concat(input1, input2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -85,7 +86,7 @@ func TestDifference(t *testing.T) {
 		input2 := []int{2, 0, 4}
 		expect := []int{1}
 
-		output := difference(input1, input2)
+		output := Difference(input1, input2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -95,7 +96,7 @@ func TestDifference(t *testing.T) {
 		input2 := []int{2, 0, 4, 1}
 		expect := []int{}
 
-		output := difference(input1, input2)
+		output := Difference(input1, input2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -110,7 +111,7 @@ func TestDifferenceBy(t *testing.T) {
 		input2 := []float64{2.3, 0.4, 4.5}
 		expect := []float64{1.4}
 
-		output := differenceBy(input1, input2, floor)
+		output := DifferenceBy(input1, input2, floor)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -120,7 +121,7 @@ func TestDifferenceBy(t *testing.T) {
 		input2 := []float64{2.3, 0.4, 4.5, 1.3}
 		expect := []float64{}
 
-		output := differenceBy(input1, input2, floor)
+		output := DifferenceBy(input1, input2, floor)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -135,7 +136,7 @@ func TestDifferenceWith(t *testing.T) {
 		input2 := []float64{2.3, 0.4, 4.5}
 		expect := []float64{1.4}
 
-		output := differenceWith(input1, input2, equals)
+		output := DifferenceWith(input1, input2, equals)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -145,7 +146,7 @@ func TestDifferenceWith(t *testing.T) {
 		input2 := []float64{2.3, 0.4, 4.5, 1.3}
 		expect := []float64{}
 
-		output := differenceWith(input1, input2, equals)
+		output := DifferenceWith(input1, input2, equals)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -157,7 +158,7 @@ func TestDrop(t *testing.T) {
 		input := []int{0, 1, 2, 3, 4}
 		expect := []int{2, 3, 4}
 
-		output := drop(input, 2)
+		output := Drop(input, 2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -166,7 +167,7 @@ func TestDrop(t *testing.T) {
 		input := []int{0, 1, 2, 3, 4}
 		expect := []int{}
 
-		output := drop(input, 10)
+		output := Drop(input, 10)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -175,7 +176,7 @@ func TestDrop(t *testing.T) {
 		input := []int{}
 		expect := []int{}
 
-		output := drop(input, 10)
+		output := Drop(input, 10)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -184,7 +185,7 @@ func TestDrop(t *testing.T) {
 		input := []int{0, 1, 2, 3, 4}
 		expect := []int{0, 1, 2, 3, 4}
 
-		output := drop(input, -1)
+		output := Drop(input, -1)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -196,7 +197,7 @@ func TestDropRight(t *testing.T) {
 		input := []int{0, 1, 2, 3, 4}
 		expect := []int{0, 1, 2}
 
-		output := dropRight(input, 2)
+		output := DropRight(input, 2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -205,7 +206,7 @@ func TestDropRight(t *testing.T) {
 		input := []int{0, 1, 2, 3, 4}
 		expect := []int{}
 
-		output := dropRight(input, 10)
+		output := DropRight(input, 10)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -214,7 +215,7 @@ func TestDropRight(t *testing.T) {
 		input := []int{}
 		expect := []int{}
 
-		output := dropRight(input, 10)
+		output := DropRight(input, 10)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -223,7 +224,7 @@ func TestDropRight(t *testing.T) {
 		input := []int{0, 1, 2, 3, 4}
 		expect := []int{0, 1, 2, 3, 4}
 
-		output := dropRight(input, -1)
+		output := DropRight(input, -1)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -237,7 +238,7 @@ func TestDropRightWhile(t *testing.T) {
 		input := []int{0, 2, 4, 1, 3}
 		expect := []int{0, 2, 4}
 
-		output := dropRightWhile(input, pred)
+		output := DropRightWhile(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -246,7 +247,7 @@ func TestDropRightWhile(t *testing.T) {
 		input := []int{1, 3, 5}
 		expect := []int{}
 
-		output := dropRightWhile(input, pred)
+		output := DropRightWhile(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -255,7 +256,7 @@ func TestDropRightWhile(t *testing.T) {
 		input := []int{0, 2, 4}
 		expect := []int{0, 2, 4}
 
-		output := dropRightWhile(input, pred)
+		output := DropRightWhile(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -264,7 +265,7 @@ func TestDropRightWhile(t *testing.T) {
 		input := []int{}
 		expect := []int{}
 
-		output := dropRightWhile(input, pred)
+		output := DropRightWhile(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -278,7 +279,7 @@ func TestDropWhile(t *testing.T) {
 		input := []int{0, 2, 4, 1, 3}
 		expect := []int{1, 3}
 
-		output := dropWhile(input, pred)
+		output := DropWhile(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -287,7 +288,7 @@ func TestDropWhile(t *testing.T) {
 		input := []int{0, 2, 4}
 		expect := []int{}
 
-		output := dropWhile(input, pred)
+		output := DropWhile(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -296,7 +297,7 @@ func TestDropWhile(t *testing.T) {
 		input := []int{1, 3, 5}
 		expect := []int{1, 3, 5}
 
-		output := dropWhile(input, pred)
+		output := DropWhile(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -305,7 +306,7 @@ func TestDropWhile(t *testing.T) {
 		input := []int{}
 		expect := []int{}
 
-		output := dropWhile(input, pred)
+		output := DropWhile(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -317,7 +318,7 @@ func TestFill(t *testing.T) {
 		input := []int{0, 1, 2, 3, 4}
 		expect := []int{0, 0, 0, 0, 0}
 
-		fill(input, 0)
+		Fill(input, 0)
 		if diff := cmp.Diff(expect, input); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -326,7 +327,7 @@ func TestFill(t *testing.T) {
 		input := []int{}
 		expect := []int{}
 
-		fill(input, 0)
+		Fill(input, 0)
 		if diff := cmp.Diff(expect, input); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -338,7 +339,7 @@ func TestFindIndex(t *testing.T) {
 		input := []int{0, 1, 2, 3, 4, 5}
 		pred := func(v int) bool { return v%2 != 0 }
 
-		output := findIndex(input, pred)
+		output := FindIndex(input, pred)
 		if diff := cmp.Diff(1, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -347,7 +348,7 @@ func TestFindIndex(t *testing.T) {
 		input := []int{0, 1, 2, 3, 4, 5}
 		pred := func(v int) bool { return v%2 == 3 }
 
-		output := findIndex(input, pred)
+		output := FindIndex(input, pred)
 		if diff := cmp.Diff(-1, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -359,7 +360,7 @@ func TestFindLastIndex(t *testing.T) {
 		input := []int{0, 1, 2, 3, 4, 5}
 		pred := func(v int) bool { return v%2 == 0 }
 
-		output := findLastIndex(input, pred)
+		output := FindLastIndex(input, pred)
 		if diff := cmp.Diff(4, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -368,19 +369,63 @@ func TestFindLastIndex(t *testing.T) {
 		input := []int{0, 1, 2, 3, 4, 5}
 		pred := func(v int) bool { return v%2 == 3 }
 
-		output := findLastIndex(input, pred)
+		output := FindLastIndex(input, pred)
 		if diff := cmp.Diff(-1, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
 	}
 }
 
+func TestFirstOk(t *testing.T) {
+	{
+		input := []string{"a", "b", "c"}
+
+		output, exist := FirstOk(input)
+		if diff := cmp.Diff("a", output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff(true, exist); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []string{}
+
+		output, exist := FirstOk(input)
+		if diff := cmp.Diff("", output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff(false, exist); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestFirstOr(t *testing.T) {
+	{
+		input := []string{"a", "b", "c"}
+
+		output := FirstOr(input, "z")
+		if diff := cmp.Diff("a", output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []string{}
+
+		output := FirstOr(input, "z")
+		if diff := cmp.Diff("z", output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
 func TestFlatten(t *testing.T) {
 	{
 		input := [][]int{{0, 1, 2}, {3, 4}, {5}}
 		expect := []int{0, 1, 2, 3, 4, 5}
 
-		output := flatten(input)
+		output := Flatten(input)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -389,7 +434,7 @@ func TestFlatten(t *testing.T) {
 		input := [][][]int{{{0, 1, 2}}, {{3, 4}, {5}}}
 		expect := [][]int{{0, 1, 2}, {3, 4}, {5}}
 
-		output := flatten(input)
+		output := Flatten(input)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -398,7 +443,7 @@ func TestFlatten(t *testing.T) {
 		input := [][]int{{}, {3, 4}, {5}}
 		expect := []int{3, 4, 5}
 
-		output := flatten(input)
+		output := Flatten(input)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -407,7 +452,7 @@ func TestFlatten(t *testing.T) {
 		input := [][]int{{}, {}, {}}
 		expect := []int{}
 
-		output := flatten(input)
+		output := Flatten(input)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -419,7 +464,7 @@ func TestHead(t *testing.T) {
 		input := []string{"a", "b", "c"}
 		expect := "a"
 
-		output := head(input)
+		output := Head(input)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -430,7 +475,7 @@ func TestIndexOf(t *testing.T) {
 	{
 		input := []string{"a", "b", "c", "a", "b", "c"}
 
-		output := indexOf(input, "c")
+		output := IndexOf(input, "c")
 		if diff := cmp.Diff(2, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -438,7 +483,7 @@ func TestIndexOf(t *testing.T) {
 	{
 		input := []string{"a", "b", "c", "a", "b", "c"}
 
-		output := indexOf(input, "GGG")
+		output := IndexOf(input, "GGG")
 		if diff := cmp.Diff(-1, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -450,7 +495,7 @@ func TestInitial(t *testing.T) {
 		input := []string{"a", "b", "c"}
 		expect := []string{"a", "b"}
 
-		output := initial(input)
+		output := Initial(input)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -459,7 +504,16 @@ func TestInitial(t *testing.T) {
 		input := []string{"a"}
 		expect := []string{}
 
-		output := initial(input)
+		output := Initial(input)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []string{}
+		expect := []string{}
+
+		output := Initial(input)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -472,7 +526,7 @@ func TestIntersection(t *testing.T) {
 		input2 := []int{2, 1, 4}
 		expect := []int{1}
 
-		output := intersection(input1, input2)
+		output := Intersection(input1, input2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -482,7 +536,7 @@ func TestIntersection(t *testing.T) {
 		input2 := []int{2, 4}
 		expect := []int{}
 
-		output := intersection(input1, input2)
+		output := Intersection(input1, input2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -497,7 +551,7 @@ func TestIntersectionBy(t *testing.T) {
 		input2 := []float64{2.3, 1.5, 4.5}
 		expect := []float64{1.4}
 
-		output := intersectionBy(input1, input2, floor)
+		output := IntersectionBy(input1, input2, floor)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -507,7 +561,7 @@ func TestIntersectionBy(t *testing.T) {
 		input2 := []float64{2.3, 4.5}
 		expect := []float64{}
 
-		output := intersectionBy(input1, input2, floor)
+		output := IntersectionBy(input1, input2, floor)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -522,7 +576,7 @@ func TestIntersectionWith(t *testing.T) {
 		input2 := []float64{2.3, 1.5, 4.5}
 		expect := []float64{1.4}
 
-		output := intersectionWith(input1, input2, equals)
+		output := IntersectionWith(input1, input2, equals)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -532,7 +586,7 @@ func TestIntersectionWith(t *testing.T) {
 		input2 := []float64{2.3, 4.5}
 		expect := []float64{}
 
-		output := intersectionWith(input1, input2, equals)
+		output := IntersectionWith(input1, input2, equals)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -544,7 +598,7 @@ func TestJoin(t *testing.T) {
 		input := []string{"a", "b", "c"}
 		expect := "a-b-c"
 
-		output := join(input, "-")
+		output := Join(input, "-")
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -556,7 +610,7 @@ func TestLast(t *testing.T) {
 		input := []string{"a", "b", "c"}
 		expect := "c"
 
-		output := last(input)
+		output := Last(input)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -567,7 +621,7 @@ func TestLastIndexOf(t *testing.T) {
 	{
 		input := []string{"a", "b", "c", "a", "b", "c"}
 
-		output := lastIndexOf(input, "a")
+		output := LastIndexOf(input, "a")
 		if diff := cmp.Diff(3, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -575,19 +629,52 @@ func TestLastIndexOf(t *testing.T) {
 	{
 		input := []string{"a", "b", "c", "a", "b", "c"}
 
-		output := lastIndexOf(input, "GGG")
+		output := LastIndexOf(input, "GGG")
 		if diff := cmp.Diff(-1, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
 	}
 }
 
+func TestLastOk(t *testing.T) {
+	{
+		input := []string{"a", "b", "c"}
+
+		output, exist := LastOk(input)
+		if diff := cmp.Diff("c", output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff(true, exist); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []string{}
+
+		_, exist := LastOk(input)
+		if diff := cmp.Diff(false, exist); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestLastOr(t *testing.T) {
+	{
+		input := []string{}
+
+		output := LastOr(input, "z")
+		if diff := cmp.Diff("z", output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
 func TestNth(t *testing.T) {
 	{
 		input := []string{"a", "b", "c", "d", "e", "f"}
 		expect := "b"
 
-		output := nth(input, 1)
+		output := Nth(input, 1)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -596,10 +683,49 @@ func TestNth(t *testing.T) {
 		input := []string{"a", "b", "c", "d", "e", "f"}
 		expect := "e"
 
-		output := nth(input, -2)
+		output := Nth(input, -2)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestNthOk(t *testing.T) {
+	input := []string{"a", "b", "c"}
+
+	for index, expect := range map[int]string{0: "a", 2: "c", -1: "c", -3: "a"} {
+		output, exist := NthOk(input, index)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
+		if diff := cmp.Diff(true, exist); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	for _, index := range []int{3, -4} {
+		_, exist := NthOk(input, index)
+		if diff := cmp.Diff(false, exist); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestNthOr(t *testing.T) {
+	{
+		input := []string{"a", "b", "c"}
+
+		output := NthOr(input, -2, "z")
+		if diff := cmp.Diff("b", output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []string{"a", "b", "c"}
+
+		output := NthOr(input, 5, "z")
+		if diff := cmp.Diff("z", output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
 	}
 }
 
@@ -608,7 +734,7 @@ func TestReverse(t *testing.T) {
 		input := []string{"a", "b", "c", "d", "e", "f"}
 		expect := []string{"f", "e", "d", "c", "b", "a"}
 
-		reverse(input)
+		Reverse(input)
 		if diff := cmp.Diff(expect, input); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -617,7 +743,7 @@ func TestReverse(t *testing.T) {
 		input := []string{"a", "b", "c", "d", "e"}
 		expect := []string{"e", "d", "c", "b", "a"}
 
-		reverse(input)
+		Reverse(input)
 		if diff := cmp.Diff(expect, input); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -626,7 +752,7 @@ func TestReverse(t *testing.T) {
 		input := []string{"a"}
 		expect := []string{"a"}
 
-		reverse(input)
+		Reverse(input)
 		if diff := cmp.Diff(expect, input); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -635,7 +761,7 @@ func TestReverse(t *testing.T) {
 		input := []string{}
 		expect := []string{}
 
-		reverse(input)
+		Reverse(input)
 		if diff := cmp.Diff(expect, input); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -647,7 +773,7 @@ func TestSlice(t *testing.T) {
 		input := []string{"a", "b", "c", "d", "e", "f"}
 		expect := []string{"c", "d"}
 
-		output := slice(input, 2, 4)
+		output := Slice(input, 2, 4)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -656,7 +782,7 @@ func TestSlice(t *testing.T) {
 		input := []string{"a", "b", "c", "d", "e", "f"}
 		expect := []string{"c", "d", "e", "f"}
 
-		output := slice(input, 2)
+		output := Slice(input, 2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -665,7 +791,7 @@ func TestSlice(t *testing.T) {
 		input := []string{"a", "b", "c", "d", "e", "f"}
 		expect := []string{"a", "b", "c", "d", "e", "f"}
 
-		output := slice(input)
+		output := Slice(input)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -677,7 +803,7 @@ func TestSortedIndex(t *testing.T) {
 		input := []int{10, 20, 30, 40, 50}
 		expect := 2
 
-		output := sortedIndex(input, 25)
+		output := SortedIndex(input, 25)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -686,7 +812,7 @@ func TestSortedIndex(t *testing.T) {
 		input := []int{10, 20, 30, 40, 50}
 		expect := 0
 
-		output := sortedIndex(input, 5)
+		output := SortedIndex(input, 5)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -695,7 +821,7 @@ func TestSortedIndex(t *testing.T) {
 		input := []int{10, 20, 30, 40, 50}
 		expect := 5
 
-		output := sortedIndex(input, 55)
+		output := SortedIndex(input, 55)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -704,7 +830,7 @@ func TestSortedIndex(t *testing.T) {
 		input := []int{10, 20, 30, 40, 50}
 		expect := 2
 
-		output := sortedIndex(input, 30)
+		output := SortedIndex(input, 30)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -713,7 +839,7 @@ func TestSortedIndex(t *testing.T) {
 		input := []int{10, 20, 20, 20, 30}
 		expect := 1
 
-		output := sortedIndex(input, 20)
+		output := SortedIndex(input, 20)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -727,7 +853,7 @@ func TestSortedIndexBy(t *testing.T) {
 		input := []int{10, 20, 30, 40, 50}
 		expect := 2
 
-		output := sortedIndexBy(input, 25, conv)
+		output := SortedIndexBy(input, 25, conv)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -736,7 +862,7 @@ func TestSortedIndexBy(t *testing.T) {
 		input := []int{10, 20, 30, 40, 50}
 		expect := 0
 
-		output := sortedIndexBy(input, 5, conv)
+		output := SortedIndexBy(input, 5, conv)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -745,7 +871,7 @@ func TestSortedIndexBy(t *testing.T) {
 		input := []int{10, 20, 30, 40, 50}
 		expect := 5
 
-		output := sortedIndexBy(input, 55, conv)
+		output := SortedIndexBy(input, 55, conv)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -754,7 +880,7 @@ func TestSortedIndexBy(t *testing.T) {
 		input := []int{10, 20, 30, 40, 50}
 		expect := 2
 
-		output := sortedIndexBy(input, 30, conv)
+		output := SortedIndexBy(input, 30, conv)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -763,7 +889,7 @@ func TestSortedIndexBy(t *testing.T) {
 		input := []int{10, 20, 20, 20, 30}
 		expect := 1
 
-		output := sortedIndexBy(input, 20, conv)
+		output := SortedIndexBy(input, 20, conv)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -775,7 +901,7 @@ func TestSortedLastIndex(t *testing.T) {
 		input := []int{10, 20, 30, 40, 50}
 		expect := 2
 
-		output := sortedLastIndex(input, 25)
+		output := SortedLastIndex(input, 25)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -784,7 +910,7 @@ func TestSortedLastIndex(t *testing.T) {
 		input := []int{10, 20, 30, 40, 50}
 		expect := 0
 
-		output := sortedLastIndex(input, 5)
+		output := SortedLastIndex(input, 5)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -793,7 +919,7 @@ func TestSortedLastIndex(t *testing.T) {
 		input := []int{10, 20, 30, 40, 50}
 		expect := 5
 
-		output := sortedLastIndex(input, 55)
+		output := SortedLastIndex(input, 55)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -802,7 +928,7 @@ func TestSortedLastIndex(t *testing.T) {
 		input := []int{10, 20, 30, 40, 50}
 		expect := 3
 
-		output := sortedLastIndex(input, 30)
+		output := SortedLastIndex(input, 30)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -811,7 +937,7 @@ func TestSortedLastIndex(t *testing.T) {
 		input := []int{10, 20, 20, 20, 30}
 		expect := 4
 
-		output := sortedLastIndex(input, 20)
+		output := SortedLastIndex(input, 20)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -825,7 +951,7 @@ func TestSortedLastIndexBy(t *testing.T) {
 		input := []int{10, 20, 30, 40, 50}
 		expect := 2
 
-		output := sortedLastIndexBy(input, 25, conv)
+		output := SortedLastIndexBy(input, 25, conv)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -834,7 +960,7 @@ func TestSortedLastIndexBy(t *testing.T) {
 		input := []int{10, 20, 30, 40, 50}
 		expect := 0
 
-		output := sortedLastIndexBy(input, 5, conv)
+		output := SortedLastIndexBy(input, 5, conv)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -843,7 +969,7 @@ func TestSortedLastIndexBy(t *testing.T) {
 		input := []int{10, 20, 30, 40, 50}
 		expect := 5
 
-		output := sortedLastIndexBy(input, 55, conv)
+		output := SortedLastIndexBy(input, 55, conv)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -852,7 +978,7 @@ func TestSortedLastIndexBy(t *testing.T) {
 		input := []int{10, 20, 30, 40, 50}
 		expect := 3
 
-		output := sortedLastIndexBy(input, 30, conv)
+		output := SortedLastIndexBy(input, 30, conv)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -861,7 +987,7 @@ func TestSortedLastIndexBy(t *testing.T) {
 		input := []int{10, 20, 20, 20, 30}
 		expect := 4
 
-		output := sortedLastIndexBy(input, 20, conv)
+		output := SortedLastIndexBy(input, 20, conv)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -873,7 +999,7 @@ func TestSortedUniq(t *testing.T) {
 		input := []int{10, 20, 20, 20, 30}
 		expect := []int{10, 20, 30}
 
-		output := sortedUniq(input)
+		output := SortedUniq(input)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -882,7 +1008,7 @@ func TestSortedUniq(t *testing.T) {
 		input := []int{10, 20, 50, 20, 30}
 		expect := []int{10, 20, 50, 20, 30}
 
-		output := sortedUniq(input)
+		output := SortedUniq(input)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -891,7 +1017,7 @@ func TestSortedUniq(t *testing.T) {
 		input := []int{}
 		expect := []int{}
 
-		output := sortedUniq(input)
+		output := SortedUniq(input)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -905,7 +1031,7 @@ func TestSortedUniqBy(t *testing.T) {
 		input := []int{10, 20, 20, 20, 30}
 		expect := []int{10, 20, 30}
 
-		output := sortedUniqBy(input, conv)
+		output := SortedUniqBy(input, conv)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -914,7 +1040,7 @@ func TestSortedUniqBy(t *testing.T) {
 		input := []int{10, 20, 50, 20, 30}
 		expect := []int{10, 20, 50, 20, 30}
 
-		output := sortedUniqBy(input, conv)
+		output := SortedUniqBy(input, conv)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -923,7 +1049,7 @@ func TestSortedUniqBy(t *testing.T) {
 		input := []int{}
 		expect := []int{}
 
-		output := sortedUniqBy(input, conv)
+		output := SortedUniqBy(input, conv)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -935,7 +1061,7 @@ func TestTail(t *testing.T) {
 		input := []string{"a", "b", "c"}
 		expect := []string{"b", "c"}
 
-		output := tail(input)
+		output := Tail(input)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -944,7 +1070,16 @@ func TestTail(t *testing.T) {
 		input := []string{"a"}
 		expect := []string{}
 
-		output := tail(input)
+		output := Tail(input)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []string{}
+		expect := []string{}
+
+		output := Tail(input)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -956,7 +1091,7 @@ func TestTake(t *testing.T) {
 		input := []string{"a", "b", "c"}
 		expect := []string{"a", "b"}
 
-		output := take(input, 2)
+		output := Take(input, 2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -965,7 +1100,7 @@ func TestTake(t *testing.T) {
 		input := []string{"a", "b", "c"}
 		expect := []string{"a", "b", "c"}
 
-		output := take(input, 5)
+		output := Take(input, 5)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -974,7 +1109,7 @@ func TestTake(t *testing.T) {
 		input := []string{"a", "b", "c"}
 		expect := []string{}
 
-		output := take(input, 0)
+		output := Take(input, 0)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -986,7 +1121,7 @@ func TestTakeRight(t *testing.T) {
 		input := []string{"a", "b", "c"}
 		expect := []string{"b", "c"}
 
-		output := takeRight(input, 2)
+		output := TakeRight(input, 2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -995,7 +1130,7 @@ func TestTakeRight(t *testing.T) {
 		input := []string{"a", "b", "c"}
 		expect := []string{"a", "b", "c"}
 
-		output := takeRight(input, 5)
+		output := TakeRight(input, 5)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1004,7 +1139,7 @@ func TestTakeRight(t *testing.T) {
 		input := []string{"a", "b", "c"}
 		expect := []string{}
 
-		output := takeRight(input, 0)
+		output := TakeRight(input, 0)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1017,7 +1152,7 @@ func TestTakeRightWhile(t *testing.T) {
 		expect := []string{"b", "c"}
 		pred := func(v string) bool { return v != "a" }
 
-		output := takeRightWhile(input, pred)
+		output := TakeRightWhile(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1027,7 +1162,7 @@ func TestTakeRightWhile(t *testing.T) {
 		expect := []string{"a", "b", "c"}
 		pred := func(v string) bool { return v != "GGG" }
 
-		output := takeRightWhile(input, pred)
+		output := TakeRightWhile(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1037,7 +1172,7 @@ func TestTakeRightWhile(t *testing.T) {
 		expect := []string{}
 		pred := func(v string) bool { return v != "c" }
 
-		output := takeRightWhile(input, pred)
+		output := TakeRightWhile(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1050,7 +1185,7 @@ func TestTakeWhile(t *testing.T) {
 		expect := []string{"a", "b"}
 		pred := func(v string) bool { return v != "c" }
 
-		output := takeWhile(input, pred)
+		output := TakeWhile(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1060,7 +1195,7 @@ func TestTakeWhile(t *testing.T) {
 		expect := []string{"a", "b", "c"}
 		pred := func(v string) bool { return v != "GGG" }
 
-		output := takeWhile(input, pred)
+		output := TakeWhile(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1070,7 +1205,7 @@ func TestTakeWhile(t *testing.T) {
 		expect := []string{}
 		pred := func(v string) bool { return v != "a" }
 
-		output := takeWhile(input, pred)
+		output := TakeWhile(input, pred)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1083,7 +1218,7 @@ func TestUnion(t *testing.T) {
 		input2 := []int{2, 1, 4}
 		expect := []int{0, 1, 2, 4}
 
-		output := union(input1, input2)
+		output := Union(input1, input2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1093,7 +1228,17 @@ func TestUnion(t *testing.T) {
 		input2 := []int{2, 4}
 		expect := []int{0, 1, 2, 4}
 
-		output := union(input1, input2)
+		output := Union(input1, input2)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input1 := []int{3, 1}
+		input2 := []int{4, 1, 4, 0}
+		expect := []int{3, 1, 4, 0}
+
+		output := Union(input1, input2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1108,7 +1253,7 @@ func TestUnionBy(t *testing.T) {
 		input2 := []float64{2.3, 1.5, 4.5}
 		expect := []float64{0.5, 1.4, 2.3, 4.5}
 
-		output := unionBy(input1, input2, floor)
+		output := UnionBy(input1, input2, floor)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1118,7 +1263,17 @@ func TestUnionBy(t *testing.T) {
 		input2 := []float64{2.3, 4.5}
 		expect := []float64{0.5, 1.4, 2.3, 4.5}
 
-		output := unionBy(input1, input2, floor)
+		output := UnionBy(input1, input2, floor)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input1 := []float64{0.5}
+		input2 := []float64{2.3, 2.7, 0.1}
+		expect := []float64{0.5, 2.3}
+
+		output := UnionBy(input1, input2, floor)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1133,7 +1288,7 @@ func TestUnionWith(t *testing.T) {
 		input2 := []float64{2.3, 1.5, 4.5}
 		expect := []float64{0.5, 1.4, 2.3, 4.5}
 
-		output := unionWith(input1, input2, equals)
+		output := UnionWith(input1, input2, equals)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1143,7 +1298,7 @@ func TestUnionWith(t *testing.T) {
 		input2 := []float64{2.3, 4.5}
 		expect := []float64{0.5, 1.4, 2.3, 4.5}
 
-		output := unionWith(input1, input2, equals)
+		output := UnionWith(input1, input2, equals)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1155,7 +1310,7 @@ func TestUniq(t *testing.T) {
 		input := []int{10, 20, 20, 20, 30}
 		expect := []int{10, 20, 30}
 
-		output := uniq(input)
+		output := Uniq(input)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1164,7 +1319,7 @@ func TestUniq(t *testing.T) {
 		input := []int{10, 20, 50, 20, 30}
 		expect := []int{10, 20, 50, 30}
 
-		output := uniq(input)
+		output := Uniq(input)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1173,7 +1328,7 @@ func TestUniq(t *testing.T) {
 		input := []int{}
 		expect := []int{}
 
-		output := uniq(input)
+		output := Uniq(input)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1187,7 +1342,7 @@ func TestUniqBy(t *testing.T) {
 		input := []int{10, 20, 20, 20, 30}
 		expect := []int{10, 20, 30}
 
-		output := uniqBy(input, conv)
+		output := UniqBy(input, conv)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1196,7 +1351,7 @@ func TestUniqBy(t *testing.T) {
 		input := []int{10, 20, 50, 20, 30}
 		expect := []int{10, 20, 50, 30}
 
-		output := uniqBy(input, conv)
+		output := UniqBy(input, conv)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1205,7 +1360,7 @@ func TestUniqBy(t *testing.T) {
 		input := []int{}
 		expect := []int{}
 
-		output := uniqBy(input, conv)
+		output := UniqBy(input, conv)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1219,7 +1374,7 @@ func TestUniqWith(t *testing.T) {
 		input := []int{10, 20, 20, 20, 30}
 		expect := []int{10, 20, 30}
 
-		output := uniqWith(input, equals)
+		output := UniqWith(input, equals)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1228,7 +1383,7 @@ func TestUniqWith(t *testing.T) {
 		input := []int{10, 20, 50, 20, 30}
 		expect := []int{10, 20, 50, 30}
 
-		output := uniqWith(input, equals)
+		output := UniqWith(input, equals)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1237,7 +1392,42 @@ func TestUniqWith(t *testing.T) {
 		input := []int{}
 		expect := []int{}
 
-		output := uniqWith(input, equals)
+		output := UniqWith(input, equals)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestUniqLast(t *testing.T) {
+	{
+		input := []int{10, 20, 50, 20, 30, 10}
+		expect := []int{50, 20, 30, 10}
+
+		output := UniqLast(input)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []int{}
+		expect := []int{}
+
+		output := UniqLast(input)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestUniqByLast(t *testing.T) {
+	floor := func(v float64) int { return int(math.Floor(v)) }
+
+	{
+		input := []float64{1.1, 2.1, 1.2, 3.1, 2.2}
+		expect := []float64{1.2, 3.1, 2.2}
+
+		output := UniqByLast(input, floor)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1252,7 +1442,7 @@ func TestUnzip(t *testing.T) {
 			{1, 2, 3},
 		}
 
-		output := unzip(input)
+		output := Unzip(input)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1261,7 +1451,7 @@ func TestUnzip(t *testing.T) {
 		input := [][]int{}
 		expect := [][]int{}
 
-		output := unzip(input)
+		output := Unzip(input)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1274,7 +1464,7 @@ func TestUnzipWith(t *testing.T) {
 		input := [][]int{{10, 1}, {20, 2}, {30, 3}}
 		expect := []int{60, 6}
 
-		output := unzipWith(sum, input)
+		output := UnzipWith(sum, input)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1283,7 +1473,7 @@ func TestUnzipWith(t *testing.T) {
 		input := [][]int{}
 		expect := []int{}
 
-		output := unzipWith(sum, input)
+		output := UnzipWith(sum, input)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1295,7 +1485,7 @@ func TestWithout(t *testing.T) {
 		input := []int{10, 20, 30, 20, 40}
 		expect := []int{10, 30, 40}
 
-		output := without(input, 20)
+		output := Without(input, 20)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1304,7 +1494,7 @@ func TestWithout(t *testing.T) {
 		input := []int{10, 20, 30, 20, 40}
 		expect := []int{10, 30}
 
-		output := without(input, 20, 40)
+		output := Without(input, 20, 40)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1317,7 +1507,7 @@ func TestXor(t *testing.T) {
 		input2 := []int{2, 3}
 		expect := []int{1, 3}
 
-		output := xor(input1, input2)
+		output := Xor(input1, input2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1327,7 +1517,7 @@ func TestXor(t *testing.T) {
 		input2 := []int{3, 4}
 		expect := []int{1, 2, 3, 4}
 
-		output := xor(input1, input2)
+		output := Xor(input1, input2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1337,7 +1527,7 @@ func TestXor(t *testing.T) {
 		input2 := []int{2, 1}
 		expect := []int{}
 
-		output := xor(input1, input2)
+		output := Xor(input1, input2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1352,7 +1542,7 @@ func TestXorBy(t *testing.T) {
 		input2 := []float64{2.2, 3.2}
 		expect := []float64{1.1, 3.2}
 
-		output := xorBy(input1, input2, floor)
+		output := XorBy(input1, input2, floor)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1362,7 +1552,7 @@ func TestXorBy(t *testing.T) {
 		input2 := []float64{3.2, 4.2}
 		expect := []float64{1.1, 2.1, 3.2, 4.2}
 
-		output := xorBy(input1, input2, floor)
+		output := XorBy(input1, input2, floor)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1372,7 +1562,7 @@ func TestXorBy(t *testing.T) {
 		input2 := []float64{2.2, 1.2}
 		expect := []float64{}
 
-		output := xorBy(input1, input2, floor)
+		output := XorBy(input1, input2, floor)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1387,7 +1577,7 @@ func TestXorWith(t *testing.T) {
 		input2 := []float64{2.2, 3.2}
 		expect := []float64{1.1, 3.2}
 
-		output := xorWith(input1, input2, equals)
+		output := XorWith(input1, input2, equals)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1397,7 +1587,7 @@ func TestXorWith(t *testing.T) {
 		input2 := []float64{3.2, 4.2}
 		expect := []float64{1.1, 2.1, 3.2, 4.2}
 
-		output := xorWith(input1, input2, equals)
+		output := XorWith(input1, input2, equals)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1407,7 +1597,7 @@ func TestXorWith(t *testing.T) {
 		input2 := []float64{2.2, 1.2}
 		expect := []float64{}
 
-		output := xorWith(input1, input2, equals)
+		output := XorWith(input1, input2, equals)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1420,7 +1610,7 @@ func TestZip(t *testing.T) {
 		input2 := []int{1, 2, 3}
 		expect := [][]int{{10, 1}, {20, 2}, {30, 3}}
 
-		output := zip(input1, input2)
+		output := Zip(input1, input2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1430,7 +1620,7 @@ func TestZip(t *testing.T) {
 		input2 := []int{1, 2, 3}
 		expect := [][]int{{10, 1}, {20, 2}, {30, 3}}
 
-		output := zip(input1, input2)
+		output := Zip(input1, input2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1440,7 +1630,7 @@ func TestZip(t *testing.T) {
 		input2 := []int{1, 2, 3, 4}
 		expect := [][]int{{10, 1}, {20, 2}, {30, 3}}
 
-		output := zip(input1, input2)
+		output := Zip(input1, input2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1450,7 +1640,7 @@ func TestZip(t *testing.T) {
 		input2 := []int{1, 2, 3}
 		expect := [][]int{}
 
-		output := zip(input1, input2)
+		output := Zip(input1, input2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1460,7 +1650,7 @@ func TestZip(t *testing.T) {
 		input2 := []int{}
 		expect := [][]int{}
 
-		output := zip(input1, input2)
+		output := Zip(input1, input2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1475,7 +1665,7 @@ func TestZipWith(t *testing.T) {
 		input2 := []int{1, 2, 3}
 		expect := []int{11, 22, 33}
 
-		output := zipWith(sum, input1, input2)
+		output := ZipWith(sum, input1, input2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1485,7 +1675,7 @@ func TestZipWith(t *testing.T) {
 		input2 := []int{1, 2, 3}
 		expect := []int{11, 22, 33}
 
-		output := zipWith(sum, input1, input2)
+		output := ZipWith(sum, input1, input2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1495,7 +1685,7 @@ func TestZipWith(t *testing.T) {
 		input2 := []int{1, 2, 3, 4}
 		expect := []int{11, 22, 33}
 
-		output := zipWith(sum, input1, input2)
+		output := ZipWith(sum, input1, input2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1505,7 +1695,7 @@ func TestZipWith(t *testing.T) {
 		input2 := []int{1, 2, 3}
 		expect := []int{}
 
-		output := zipWith(sum, input1, input2)
+		output := ZipWith(sum, input1, input2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
@@ -1515,9 +1705,93 @@ func TestZipWith(t *testing.T) {
 		input2 := []int{}
 		expect := []int{}
 
-		output := zipWith(sum, input1, input2)
+		output := ZipWith(sum, input1, input2)
 		if diff := cmp.Diff(expect, output); diff != "" {
 			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
 		}
 	}
 }
+
+// The naive* functions are the former O(n*m) implementations, kept as a
+// baseline for the set operation benchmarks.
+
+func naiveDifference[T comparable](a1 []T, a2 []T) []T {
+	n := make([]T, 0)
+	for _, v := range a1 {
+		if !Includes(a2, v) {
+			n = append(n, v)
+		}
+	}
+	return n
+}
+
+func naiveIntersection[T comparable](a1 []T, a2 []T) []T {
+	n := make([]T, 0)
+	for _, v := range a1 {
+		if Includes(a2, v) {
+			n = append(n, v)
+		}
+	}
+	return n
+}
+
+func naiveUnion[T comparable](a1 []T, a2 []T) []T {
+	n := make([]T, len(a1))
+	copy(n, a1)
+
+	for _, v := range a2 {
+		if !Includes(n, v) {
+			n = append(n, v)
+		}
+	}
+	return n
+}
+
+func naiveXor[T comparable](a1, a2 []T) []T {
+	out1 := naiveDifference(a1, a2)
+	out2 := naiveDifference(a2, a1)
+	return Concat(out1, out2)
+}
+
+func benchmarkSetInput(size int) ([]int, []int) {
+	a1 := make([]int, size)
+	a2 := make([]int, size)
+	for i := 0; i < size; i++ {
+		a1[i] = i
+		a2[i] = i + size/2
+	}
+	return a1, a2
+}
+
+func benchmarkSetOperation(b *testing.B, hashed, naive func([]int, []int) []int) {
+	for _, size := range []int{100, 10000} {
+		a1, a2 := benchmarkSetInput(size)
+
+		b.Run(fmt.Sprintf("hash/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				hashed(a1, a2)
+			}
+		})
+		b.Run(fmt.Sprintf("naive/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				naive(a1, a2)
+			}
+		})
+	}
+}
+
+func BenchmarkDifference(b *testing.B) {
+	benchmarkSetOperation(b, Difference[int], naiveDifference[int])
+}
+
+func BenchmarkIntersection(b *testing.B) {
+	benchmarkSetOperation(b, Intersection[int], naiveIntersection[int])
+}
+
+func BenchmarkUnion(b *testing.B) {
+	benchmarkSetOperation(b, Union[int], naiveUnion[int])
+}
+
+func BenchmarkXor(b *testing.B) {
+	benchmarkSetOperation(b, Xor[int], naiveXor[int])
+}
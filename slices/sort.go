@@ -0,0 +1,82 @@
+package slices
+
+import (
+	"cmp"
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Order compares two elements by a single sort key, returning a negative
+// number, zero or a positive number. Build one with Asc or Desc.
+type Order[T any] func(a, b T) int
+
+// Asc orders elements by the key generated by conv in ascending order.
+func Asc[T any, U constraints.Ordered](conv func(T) U) Order[T] {
+	return func(a, b T) int {
+		return cmp.Compare(conv(a), conv(b))
+	}
+}
+
+// Desc orders elements by the key generated by conv in descending order.
+func Desc[T any, U constraints.Ordered](conv func(T) U) Order[T] {
+	return func(a, b T) int {
+		return cmp.Compare(conv(b), conv(a))
+	}
+}
+
+// OrderBy returns a sorted copy of ary. Elements are compared by each order
+// in turn, so later orders only break ties of earlier ones. The sort is
+// stable.
+func OrderBy[T any](ary []T, orders ...Order[T]) []T {
+	out := make([]T, len(ary))
+	copy(out, ary)
+	OrderByInPlace(out, orders...)
+	return out
+}
+
+// OrderByInPlace is like OrderBy, but sorts ary in place.
+func OrderByInPlace[T any](ary []T, orders ...Order[T]) {
+	sort.SliceStable(ary, func(i, j int) bool {
+		for _, order := range orders {
+			if c := order(ary[i], ary[j]); c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+}
+
+// SortBy returns a copy of ary sorted in ascending order of the key generated
+// by conv. The sort is stable, and conv is called once per element. The same
+// conv can be passed to SortedIndexBy to insert into the result.
+func SortBy[T any, U constraints.Ordered](ary []T, conv func(T) U) []T {
+	out := make([]T, len(ary))
+	copy(out, ary)
+	SortByInPlace(out, conv)
+	return out
+}
+
+// SortByInPlace is like SortBy, but sorts ary in place.
+func SortByInPlace[T any, U constraints.Ordered](ary []T, conv func(T) U) {
+	sort.Stable(keyed[T, U]{ary: ary, keys: Map(ary, conv)})
+}
+
+// keyed sorts a slice by precomputed keys, keeping both in step.
+type keyed[T any, U constraints.Ordered] struct {
+	ary  []T
+	keys []U
+}
+
+func (s keyed[T, U]) Len() int {
+	return len(s.ary)
+}
+
+func (s keyed[T, U]) Less(i, j int) bool {
+	return cmp.Less(s.keys[i], s.keys[j])
+}
+
+func (s keyed[T, U]) Swap(i, j int) {
+	s.ary[i], s.ary[j] = s.ary[j], s.ary[i]
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
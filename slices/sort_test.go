@@ -0,0 +1,118 @@
+package slices
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+type sortUser struct {
+	Name string
+	Age  int
+}
+
+func TestOrderBy(t *testing.T) {
+	name := func(u sortUser) string { return u.Name }
+	age := func(u sortUser) int { return u.Age }
+
+	{
+		input := []sortUser{{"fred", 48}, {"barney", 34}, {"fred", 40}, {"barney", 36}}
+		expect := []sortUser{{"barney", 36}, {"barney", 34}, {"fred", 48}, {"fred", 40}}
+
+		output := OrderBy(input, Asc(name), Desc(age))
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []sortUser{{"fred", 48}, {"barney", 34}, {"fred", 40}, {"barney", 36}}
+		expect := []sortUser{{"fred", 48}, {"fred", 40}, {"barney", 34}, {"barney", 36}}
+
+		output := OrderBy(input, Desc(name))
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []sortUser{{"fred", 48}, {"barney", 34}}
+		expect := []sortUser{{"fred", 48}, {"barney", 34}}
+
+		OrderBy(input, Asc(name))
+		if diff := cmp.Diff(expect, input); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		nan := math.NaN()
+		input := []float64{5, 3, nan, 1, 4, 2, nan, 0}
+		expect := []float64{nan, nan, 0, 1, 2, 3, 4, 5}
+		id := func(v float64) float64 { return v }
+
+		output := OrderBy(input, Asc(id))
+		if diff := cmp.Diff(expect, output, cmpopts.EquateNaNs()); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestOrderByInPlace(t *testing.T) {
+	{
+		input := []sortUser{{"fred", 48}, {"barney", 34}, {"fred", 40}}
+		expect := []sortUser{{"barney", 34}, {"fred", 40}, {"fred", 48}}
+
+		OrderByInPlace(input, Asc(func(u sortUser) int { return u.Age }))
+		if diff := cmp.Diff(expect, input); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestSortBy(t *testing.T) {
+	age := func(u sortUser) int { return u.Age }
+
+	{
+		input := []sortUser{{"fred", 48}, {"barney", 34}, {"pebbles", 48}, {"wilma", 40}}
+		expect := []sortUser{{"barney", 34}, {"wilma", 40}, {"fred", 48}, {"pebbles", 48}}
+
+		output := SortBy(input, age)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []sortUser{{"fred", 48}, {"barney", 34}, {"wilma", 40}}
+		expect := []sortUser{{"barney", 34}, {"betty", 36}, {"wilma", 40}, {"fred", 48}}
+
+		output := SortBy(input, age)
+		value := sortUser{"betty", 36}
+		index := SortedIndexBy(output, value, age)
+		output = Concat(output[:index], Concat([]sortUser{value}, output[index:]))
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		nan := math.NaN()
+		input := []float64{5, 3, nan, 1, 4, 2, nan, 0}
+		expect := []float64{nan, nan, 0, 1, 2, 3, 4, 5}
+
+		output := SortBy(input, func(v float64) float64 { return v })
+		if diff := cmp.Diff(expect, output, cmpopts.EquateNaNs()); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestSortByInPlace(t *testing.T) {
+	{
+		input := []string{"ccc", "a", "bb", "d"}
+		expect := []string{"a", "d", "bb", "ccc"}
+
+		SortByInPlace(input, func(v string) int { return len(v) })
+		if diff := cmp.Diff(expect, input); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
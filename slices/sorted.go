@@ -0,0 +1,120 @@
+package slices
+
+import (
+	"cmp"
+
+	"golang.org/x/exp/constraints"
+)
+
+// The helpers in this file expect ary to be sorted in ascending order, either
+// by value or by the key generated by conv, as produced by SortBy. Like
+// SortBy, they order NaN keys before all other values and treat them as equal
+// to each other.
+
+// SortedContains reports whether value is present in the sorted slice ary.
+func SortedContains[T constraints.Ordered](ary []T, value T) bool {
+	return SortedIndexOf(ary, value) != -1
+}
+
+// SortedContainsBy is like SortedContains, but compares the keys generated by
+// conv.
+func SortedContainsBy[T any, U constraints.Ordered](ary []T, value T, conv func(T) U) bool {
+	return SortedIndexOfBy(ary, value, conv) != -1
+}
+
+// SortedIndexOf is like IndexOf, but performs a binary search on the sorted
+// slice ary.
+func SortedIndexOf[T constraints.Ordered](ary []T, value T) int {
+	identity := func(v T) T { return v }
+	return SortedIndexOfBy(ary, value, identity)
+}
+
+// SortedIndexOfBy is like SortedIndexOf, but compares the keys generated by
+// conv.
+func SortedIndexOfBy[T any, U constraints.Ordered](ary []T, value T, conv func(T) U) int {
+	index := SortedIndexBy(ary, value, conv)
+	if index < len(ary) && cmp.Compare(conv(ary[index]), conv(value)) == 0 {
+		return index
+	}
+	return -1
+}
+
+// SortedInsert inserts value into the sorted slice ary after any equal
+// elements, keeping it sorted. Like append, it may reuse the backing array of
+// ary, so the result must be used in place of ary.
+func SortedInsert[T constraints.Ordered](ary []T, value T) []T {
+	identity := func(v T) T { return v }
+	return SortedInsertBy(ary, value, identity)
+}
+
+// SortedInsertBy is like SortedInsert, but compares the keys generated by conv.
+func SortedInsertBy[T any, U constraints.Ordered](ary []T, value T, conv func(T) U) []T {
+	index := SortedLastIndexBy(ary, value, conv)
+
+	var zero T
+	ary = append(ary, zero)
+	copy(ary[index+1:], ary[index:])
+	ary[index] = value
+	return ary
+}
+
+// SortedLastIndexOf is like LastIndexOf, but performs a binary search on the
+// sorted slice ary.
+func SortedLastIndexOf[T constraints.Ordered](ary []T, value T) int {
+	identity := func(v T) T { return v }
+	return SortedLastIndexOfBy(ary, value, identity)
+}
+
+// SortedLastIndexOfBy is like SortedLastIndexOf, but compares the keys
+// generated by conv.
+func SortedLastIndexOfBy[T any, U constraints.Ordered](ary []T, value T, conv func(T) U) int {
+	index := SortedLastIndexBy(ary, value, conv) - 1
+	if index >= 0 && cmp.Compare(conv(ary[index]), conv(value)) == 0 {
+		return index
+	}
+	return -1
+}
+
+// SortedMerge merges the sorted slices a1 and a2 into a new sorted slice.
+// Elements of a1 come before equal elements of a2.
+func SortedMerge[T constraints.Ordered](a1 []T, a2 []T) []T {
+	identity := func(v T) T { return v }
+	return SortedMergeBy(a1, a2, identity)
+}
+
+// SortedMergeBy is like SortedMerge, but compares the keys generated by conv.
+func SortedMergeBy[T any, U constraints.Ordered](a1 []T, a2 []T, conv func(T) U) []T {
+	out := make([]T, 0, len(a1)+len(a2))
+	i, j := 0, 0
+	for i < len(a1) && j < len(a2) {
+		if cmp.Less(conv(a2[j]), conv(a1[i])) {
+			out = append(out, a2[j])
+			j++
+		} else {
+			out = append(out, a1[i])
+			i++
+		}
+	}
+
+	out = append(out, a1[i:]...)
+	out = append(out, a2[j:]...)
+	return out
+}
+
+// SortedRemove removes every element equal to value from the sorted slice
+// ary. It modifies ary in place, so the result must be used in place of ary.
+func SortedRemove[T constraints.Ordered](ary []T, value T) []T {
+	identity := func(v T) T { return v }
+	return SortedRemoveBy(ary, value, identity)
+}
+
+// SortedRemoveBy is like SortedRemove, but removes every element whose key
+// generated by conv equals that of value.
+func SortedRemoveBy[T any, U constraints.Ordered](ary []T, value T, conv func(T) U) []T {
+	start := SortedIndexBy(ary, value, conv)
+	end := SortedLastIndexBy(ary, value, conv)
+	if start == end {
+		return ary
+	}
+	return append(ary[:start], ary[end:]...)
+}
@@ -0,0 +1,203 @@
+package slices
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestSortedContains(t *testing.T) {
+	{
+		input := []int{10, 20, 20, 30}
+
+		if !SortedContains(input, 20) {
+			t.Errorf("20 is not found in %v", input)
+		}
+		if SortedContains(input, 25) {
+			t.Errorf("25 is found in %v", input)
+		}
+	}
+	{
+		floor := func(v float64) int { return int(math.Floor(v)) }
+		input := []float64{1.5, 2.5, 3.5}
+
+		if !SortedContainsBy(input, 2.1, floor) {
+			t.Errorf("2.1 is not found in %v", input)
+		}
+	}
+}
+
+func TestSortedIndexOf(t *testing.T) {
+	input := []int{10, 20, 20, 20, 30}
+
+	for value, expect := range map[int]int{10: 0, 20: 1, 30: 4, 5: -1, 25: -1, 40: -1} {
+		output := SortedIndexOf(input, value)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("SortedIndexOf(%d) is missmatch (-expect, +result):\n%s", value, diff)
+		}
+	}
+	{
+		output := SortedIndexOf([]int{}, 10)
+		if diff := cmp.Diff(-1, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestSortedIndexOfBy(t *testing.T) {
+	floor := func(v float64) int { return int(math.Floor(v)) }
+
+	{
+		input := []float64{1.1, 2.2, 2.8, 3.3}
+
+		output := SortedIndexOfBy(input, 2.5, floor)
+		if diff := cmp.Diff(1, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestSortedInsert(t *testing.T) {
+	{
+		input := []int{10, 20, 30}
+		expect := []int{10, 20, 25, 30}
+
+		output := SortedInsert(input, 25)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []int{}
+		expect := []int{5}
+
+		output := SortedInsert(input, 5)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		nan := math.NaN()
+		input := SortBy([]float64{2, nan, 0, nan, nan}, func(v float64) float64 { return v })
+		expect := []float64{nan, nan, nan, 0, 1, 2}
+
+		output := SortedInsert(input, 1)
+		if diff := cmp.Diff(expect, output, cmpopts.EquateNaNs()); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+		if diff := cmp.Diff([]int{0, 2, 4}, []int{SortedIndexOf(output, nan), SortedLastIndexOf(output, nan), SortedIndex(output, 0.5)}); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestSortedInsertBy(t *testing.T) {
+	floor := func(v float64) int { return int(math.Floor(v)) }
+
+	{
+		input := []float64{1.5, 2.5, 3.5}
+		expect := []float64{1.5, 2.5, 2.1, 3.5}
+
+		output := SortedInsertBy(input, 2.1, floor)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestSortedLastIndexOf(t *testing.T) {
+	input := []int{10, 20, 20, 20, 30}
+
+	for value, expect := range map[int]int{10: 0, 20: 3, 30: 4, 5: -1, 25: -1, 40: -1} {
+		output := SortedLastIndexOf(input, value)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("SortedLastIndexOf(%d) is missmatch (-expect, +result):\n%s", value, diff)
+		}
+	}
+}
+
+func TestSortedMerge(t *testing.T) {
+	{
+		input1 := []int{1, 3, 5, 7}
+		input2 := []int{2, 3, 6}
+		expect := []int{1, 2, 3, 3, 5, 6, 7}
+
+		output := SortedMerge(input1, input2)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input1 := []int{}
+		input2 := []int{}
+		expect := []int{}
+
+		output := SortedMerge(input1, input2)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		nan := math.NaN()
+		input1 := []float64{nan, 1, 3}
+		input2 := []float64{nan, 2}
+		expect := []float64{nan, nan, 1, 2, 3}
+
+		output := SortedMerge(input1, input2)
+		if diff := cmp.Diff(expect, output, cmpopts.EquateNaNs()); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestSortedMergeBy(t *testing.T) {
+	floor := func(v float64) int { return int(math.Floor(v)) }
+
+	{
+		input1 := []float64{1.9, 2.9}
+		input2 := []float64{1.1, 2.1, 3.1}
+		expect := []float64{1.9, 1.1, 2.9, 2.1, 3.1}
+
+		output := SortedMergeBy(input1, input2, floor)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestSortedRemove(t *testing.T) {
+	{
+		input := []int{10, 20, 20, 30}
+		expect := []int{10, 30}
+
+		output := SortedRemove(input, 20)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+	{
+		input := []int{10, 20, 30}
+		expect := []int{10, 20, 30}
+
+		output := SortedRemove(input, 25)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}
+
+func TestSortedRemoveBy(t *testing.T) {
+	floor := func(v float64) int { return int(math.Floor(v)) }
+
+	{
+		input := []float64{1.5, 2.1, 2.9, 3.5}
+		expect := []float64{1.5, 3.5}
+
+		output := SortedRemoveBy(input, 2.0, floor)
+		if diff := cmp.Diff(expect, output); diff != "" {
+			t.Errorf("result is missmatch (-expect, +result):\n%s", diff)
+		}
+	}
+}